@@ -1,12 +1,23 @@
 CREATE TABLE IF NOT EXISTS teams (
-    team_name TEXT PRIMARY KEY
+    team_name TEXT PRIMARY KEY,
+    parent_team_name TEXT REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE SET NULL
 );
 
 CREATE TABLE IF NOT EXISTS users (
     user_id TEXT PRIMARY KEY,
     username TEXT NOT NULL,
-    team_name TEXT REFERENCES teams(team_name),
-    is_active BOOLEAN NOT NULL
+    team_name TEXT REFERENCES teams(team_name) ON UPDATE CASCADE,
+    is_active BOOLEAN NOT NULL,
+    role TEXT NOT NULL DEFAULT 'member' CHECK (role IN ('member', 'lead')),
+    offboarded_at TIMESTAMP WITH TIME ZONE,
+    inactive_until TIMESTAMP WITH TIME ZONE,
+    email TEXT,
+    chat_handle TEXT,
+    preferred_language TEXT,
+    notify_assigned BOOLEAN NOT NULL DEFAULT true,
+    notify_reassigned BOOLEAN NOT NULL DEFAULT true,
+    notify_overdue BOOLEAN NOT NULL DEFAULT true,
+    notify_merged BOOLEAN NOT NULL DEFAULT true
 );
 
 CREATE TABLE IF NOT EXISTS pull_requests (
@@ -15,11 +26,89 @@ CREATE TABLE IF NOT EXISTS pull_requests (
     author_id TEXT NOT NULL,
     status TEXT NOT NULL,
     created_at TIMESTAMP WITH TIME ZONE,
-    merged_at TIMESTAMP WITH TIME ZONE
+    merged_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL DEFAULT 1
 );
 
 CREATE TABLE IF NOT EXISTS pr_reviewers (
     id SERIAL PRIMARY KEY,
     pull_request_id TEXT REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
-    user_id TEXT REFERENCES users(user_id)
-);
\ No newline at end of file
+    user_id TEXT REFERENCES users(user_id),
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    started_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS pull_request_history (
+    id SERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    details JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS team_membership_history (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id),
+    team_name TEXT NOT NULL,
+    valid_from TIMESTAMP WITH TIME ZONE,
+    valid_to TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_membership_history_user ON team_membership_history (user_id, valid_from);
+
+CREATE TABLE IF NOT EXISTS team_memberships (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE,
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    review_weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (review_weight >= 0),
+    PRIMARY KEY (user_id, team_name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_memberships_primary ON team_memberships (user_id) WHERE is_primary;
+CREATE INDEX IF NOT EXISTS idx_team_memberships_team ON team_memberships (team_name);
+
+INSERT INTO team_memberships (user_id, team_name, is_primary)
+SELECT user_id, team_name, true
+FROM users
+WHERE team_name IS NOT NULL
+ON CONFLICT (user_id, team_name) DO NOTHING;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_active ON users (team_name, is_active);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_inactive_until ON users (inactive_until) WHERE inactive_until IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS reviewer_counters (
+    user_id TEXT PRIMARY KEY REFERENCES users(user_id),
+    reviews INTEGER NOT NULL DEFAULT 0,
+    open_reviews INTEGER NOT NULL DEFAULT 0,
+    merged_reviews INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO reviewer_counters (user_id, reviews, open_reviews, merged_reviews)
+SELECT 
+    prr.user_id, 
+    COUNT(*), 
+    COUNT(*) FILTER (WHERE pr.status = 'OPEN'), 
+    COUNT(*) FILTER (WHERE pr.status = 'MERGED')
+FROM pr_reviewers prr
+INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+WHERE pr.deleted_at IS NULL
+GROUP BY prr.user_id
+ON CONFLICT (user_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS review_load_snapshots (
+    snapshot_date DATE NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(user_id),
+    team_name TEXT,
+    open_reviews INTEGER NOT NULL,
+    completed_reviews INTEGER NOT NULL,
+    taken_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (snapshot_date, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_load_snapshots_user ON review_load_snapshots (user_id, snapshot_date);
+CREATE INDEX IF NOT EXISTS idx_review_load_snapshots_team ON review_load_snapshots (team_name, snapshot_date);
@@ -0,0 +1,76 @@
+package handlers_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"integration-tests/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboard_Integration(t *testing.T) {
+	getPage := func(t *testing.T, path string) (int, string, string) {
+		resp, err := http.Get(helpers.GetAPIURL() + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, resp.Header.Get("Content-Type"), string(body)
+	}
+
+	t.Run("Team page shows members, open PRs and activity", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("dashboard_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Dashboard PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		status, contentType, body := getPage(t, "/dashboard")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "text/html; charset=utf-8", contentType)
+		assert.Contains(t, body, teamName)
+
+		status, _, body = getPage(t, "/dashboard/team?team_name="+url.QueryEscape(teamName))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, reviewerID)
+		assert.Contains(t, body, "Dashboard PR")
+		assert.Contains(t, body, "<svg")
+		assert.Contains(t, body, "Nothing overdue.")
+
+		status, contentType, _ = getPage(t, "/dashboard/static/dashboard.css")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, contentType, "text/css")
+	})
+
+	t.Run("Team page returns 404 for unknown team", func(t *testing.T) {
+		status, _, _ := getPage(t, "/dashboard/team?team_name=nonexistent_dashboard_team")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
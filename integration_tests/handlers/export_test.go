@@ -0,0 +1,102 @@
+package handlers_test
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"integration-tests/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportHandler_Integration(t *testing.T) {
+
+	t.Run("Exports stream reviews stats, PRs and rosters as CSV", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("export_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": "=HYPERLINK(\"x\")", "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Export PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		readCSV := func(endpoint string) [][]string {
+			resp, err := helpers.MakeRequest("GET", endpoint, nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+
+			records, err := csv.NewReader(resp.Body).ReadAll()
+			require.NoError(t, err)
+			require.NotEmpty(t, records)
+			return records
+		}
+
+		records := readCSV(fmt.Sprintf("/export/reviewsStats.csv?team_name=%s", teamName))
+		assert.Equal(t, []string{"user_id", "username", "team_name", "is_active", "reviews_number", "open_reviews", "merged_reviews"}, records[0])
+		assert.Equal(t, [][]string{
+			{authorID, `'=HYPERLINK("x")`, teamName, "true", "0", "0", "0"},
+			{reviewerID, reviewerID, teamName, "true", "1", "1", "0"},
+		}, records[1:])
+
+		records = readCSV(fmt.Sprintf("/export/pullRequests.csv?author_id=%s&status=OPEN", authorID))
+		require.Len(t, records, 2)
+		assert.Equal(t, testID, records[1][0])
+		assert.Equal(t, "OPEN", records[1][3])
+		assert.NotEmpty(t, records[1][4])
+		assert.Empty(t, records[1][5])
+		assert.Equal(t, reviewerID, records[1][6])
+
+		records = readCSV(fmt.Sprintf("/export/teams.csv?team_name=%s", teamName))
+		assert.Equal(t, []string{"team_name", "user_id", "username", "is_active", "is_primary", "role"}, records[0])
+		require.Len(t, records, 3)
+		roster := make(map[string][]string)
+		for _, rec := range records[1:] {
+			roster[rec[1]] = rec
+		}
+		assert.Equal(t, `'=HYPERLINK("x")`, roster[authorID][2])
+		assert.Equal(t, []string{teamName, reviewerID, reviewerID, "true", "true", "member"}, roster[reviewerID])
+	})
+
+	t.Run("Export with no rows returns only the header", func(t *testing.T) {
+		resp, err := helpers.MakeRequest("GET", "/export/teams.csv?team_name=nonexistent_export_team", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("Export rejects invalid status", func(t *testing.T) {
+		resp, err := helpers.MakeRequest("GET", "/export/pullRequests.csv?status=CLOSED", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
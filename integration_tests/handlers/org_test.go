@@ -0,0 +1,122 @@
+package handlers_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"integration-tests/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrgHandler_Integration(t *testing.T) {
+	t.Run("Import dry run reports diff without applying it", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("org_import_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		userID := fmt.Sprintf("user_%s", testID)
+
+		doc := fmt.Sprintf("team_name,user_id,username\n%s,%s,%s\n", teamName, userID, userID)
+
+		resp, err := helpers.MakeRawRequest("POST", "/org/import?dry_run=true", "text/csv", []byte(doc))
+		require.NoError(t, err)
+
+		var result struct {
+			Diff struct {
+				AddedTeams []string `json:"added_teams"`
+				Added      []struct {
+					UserID string `json:"user_id"`
+					ToTeam string `json:"to_team"`
+				} `json:"added"`
+				Applied bool `json:"applied"`
+			} `json:"diff"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, []string{teamName}, result.Diff.AddedTeams)
+		require.Len(t, result.Diff.Added, 1)
+		assert.Equal(t, userID, result.Diff.Added[0].UserID)
+		assert.False(t, result.Diff.Applied)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/team/get?team_name=%s", teamName), nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Import dry run lists open reviews of deactivated users", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("org_reviews_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+
+		members := []map[string]interface{}{{"user_id": authorID, "username": authorID, "is_active": true}}
+		for i := 1; i <= 3; i++ {
+			userID := fmt.Sprintf("reviewer%d_%s", i, testID)
+			members = append(members, map[string]interface{}{"user_id": userID, "username": userID, "is_active": true})
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Org import PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		err = helpers.ParseResponse(resp, &created)
+		require.NoError(t, err)
+		require.NotEmpty(t, created.PR.AssignedReviewers)
+		leaverID := created.PR.AssignedReviewers[0]
+
+		doc := "team_name,user_id,username,is_active\n"
+		for _, m := range members {
+			userID := m["user_id"].(string)
+			doc += fmt.Sprintf("%s,%s,%s,%t\n", teamName, userID, userID, userID != leaverID)
+		}
+
+		resp, err = helpers.MakeRawRequest("POST", "/org/import?dry_run=true", "text/csv", []byte(doc))
+		require.NoError(t, err)
+
+		var result struct {
+			Diff struct {
+				OpenReviews []struct {
+					UserID        string `json:"user_id"`
+					PullRequestID string `json:"pull_request_id"`
+				} `json:"open_reviews"`
+				Applied bool `json:"applied"`
+			} `json:"diff"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.False(t, result.Diff.Applied)
+
+		found := false
+		for _, r := range result.Diff.OpenReviews {
+			if r.UserID == leaverID && r.PullRequestID == testID {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("Import returns 400 for invalid document", func(t *testing.T) {
+		resp, err := helpers.MakeRawRequest("POST", "/org/import?dry_run=true", "application/yaml", []byte("teams: ["))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -156,6 +156,174 @@ func TestPullRequestHandler_Integration(t *testing.T) {
 		assert.NotNil(t, result["replaced_by"])
 	})
 
+	t.Run("BatchCreatePR reports result per item", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("pr_batch_%s_%d", t.Name(), timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		err := setupTeam(teamName, []map[string]interface{}{
+			{"user_id": authorID, "username": authorID, "is_active": true},
+			{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+		})
+		require.NoError(t, err)
+
+		batch := map[string]interface{}{
+			"pull_requests": []map[string]interface{}{
+				{"pull_request_id": testID + "_1", "pull_request_name": "Batch PR 1", "author_id": authorID},
+				{"pull_request_id": testID + "_1", "pull_request_name": "Batch PR 1 again", "author_id": authorID},
+				{"pull_request_id": testID + "_2", "pull_request_name": "Batch PR 2", "author_id": "nonexistent"},
+			},
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/pullRequest/batchCreate", batch)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Results []struct {
+				PR    map[string]interface{} `json:"pr"`
+				Error map[string]interface{} `json:"error"`
+			} `json:"results"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		require.Len(t, result.Results, 3)
+		assert.NotNil(t, result.Results[0].PR)
+		assert.Equal(t, "PR_EXISTS", result.Results[1].Error["code"])
+		assert.Equal(t, "NOT_FOUND", result.Results[2].Error["code"])
+	})
+
+	t.Run("StartReview marks review as picked up", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("pr_start_%s_%d", t.Name(), timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		err := setupTeam(teamName, []map[string]interface{}{
+			{"user_id": authorID, "username": authorID, "is_active": true},
+			{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+		})
+		require.NoError(t, err)
+
+		pr := map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Test PR",
+			"author_id":         authorID,
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/pullRequest/create", pr)
+		require.NoError(t, err)
+		resp.Body.Close()
+		etag := resp.Header.Get("ETag")
+
+		startReq := map[string]interface{}{
+			"pull_request_id": testID,
+			"user_id":         reviewerID,
+		}
+
+		resp, err = helpers.MakeRequestWithHeaders("POST", "/pullRequest/startReview", startReq, map[string]string{"If-Match": etag})
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+		var result map[string]interface{}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		review, ok := result["review"].(map[string]interface{})
+		require.True(t, ok)
+		assert.NotNil(t, review["startedAt"])
+
+		resp, err = helpers.MakeRequestWithHeaders("POST", "/pullRequest/startReview", startReq, map[string]string{"If-Match": etag})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("DeletePR removes pull request", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("pr_delete_%s_%d", t.Name(), timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		err := setupTeam(teamName, []map[string]interface{}{
+			{"user_id": authorID, "username": authorID, "is_active": true},
+			{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+		})
+		require.NoError(t, err)
+
+		pr := map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Test PR",
+			"author_id":         authorID,
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/pullRequest/create", pr)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		deleteReq := map[string]interface{}{
+			"pull_request_id": testID,
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/delete", deleteReq)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/merge", deleteReq)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("MergePR returns 412 on stale If-Match", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("pr_ifmatch_%s_%d", t.Name(), timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+
+		err := setupTeam(teamName, []map[string]interface{}{
+			{"user_id": authorID, "username": authorID, "is_active": true},
+		})
+		require.NoError(t, err)
+
+		pr := map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Test PR",
+			"author_id":         authorID,
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/pullRequest/create", pr)
+		require.NoError(t, err)
+		etag := resp.Header.Get("ETag")
+		resp.Body.Close()
+		require.NotEmpty(t, etag)
+
+		mergeReq := map[string]interface{}{
+			"pull_request_id": testID,
+		}
+
+		resp, err = helpers.MakeRequestWithHeaders("POST", "/pullRequest/merge", mergeReq, map[string]string{"If-Match": etag})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+		resp, err = helpers.MakeRequestWithHeaders("POST", "/pullRequest/delete", mergeReq, map[string]string{"If-Match": etag})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
 	t.Run("CreatePR returns 404 for non-existent author", func(t *testing.T) {
 		timestamp := time.Now().UnixNano()
 		testID := fmt.Sprintf("pr_notfound_%s_%d", t.Name(), timestamp)
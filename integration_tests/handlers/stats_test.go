@@ -0,0 +1,406 @@
+package handlers_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"integration-tests/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsHandler_Integration(t *testing.T) {
+
+	t.Run("CycleTime reports merge and first review percentiles", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("stats_cycle_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		for i := 1; i <= 2; i++ {
+			resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr%d_%s", i, testID),
+				"pull_request_name": "Cycle time PR",
+				"author_id":         authorID,
+			})
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+
+		prID := fmt.Sprintf("pr1_%s", testID)
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/startReview", map[string]interface{}{"pull_request_id": prID, "user_id": reviewerID})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/merge", map[string]interface{}{"pull_request_id": prID})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", "/stats/cycleTime?group_by=author", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		type percentiles struct {
+			P50 *float64 `json:"p50"`
+			P90 *float64 `json:"p90"`
+			P99 *float64 `json:"p99"`
+		}
+		var result struct {
+			CycleTime struct {
+				GroupBy string `json:"group_by"`
+				Groups  []struct {
+					Key               string      `json:"key"`
+					PRsCreated        int         `json:"prs_created"`
+					PRsMerged         int         `json:"prs_merged"`
+					TimeToMerge       percentiles `json:"time_to_merge"`
+					PRsReviewed       int         `json:"prs_reviewed"`
+					TimeToFirstReview percentiles `json:"time_to_first_review"`
+				} `json:"groups"`
+			} `json:"cycle_time"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "author", result.CycleTime.GroupBy)
+
+		found := false
+		for _, g := range result.CycleTime.Groups {
+			if g.Key != authorID {
+				continue
+			}
+			found = true
+			assert.Equal(t, 2, g.PRsCreated)
+			assert.Equal(t, 1, g.PRsMerged)
+			assert.Equal(t, 1, g.PRsReviewed)
+			require.NotNil(t, g.TimeToMerge.P50)
+			require.NotNil(t, g.TimeToMerge.P99)
+			require.NotNil(t, g.TimeToFirstReview.P50)
+			assert.GreaterOrEqual(t, *g.TimeToMerge.P50, *g.TimeToFirstReview.P50)
+		}
+		assert.True(t, found)
+
+		resp, err = helpers.MakeRequest("GET", "/stats/cycleTime?group_by=team", nil)
+		require.NoError(t, err)
+
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+
+		found = false
+		for _, g := range result.CycleTime.Groups {
+			if g.Key == teamName {
+				found = true
+				assert.Equal(t, 2, g.PRsCreated)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("Fairness reports assignment spread per team", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("stats_fairness_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewer1ID := fmt.Sprintf("reviewer1_%s", testID)
+		reviewer2ID := fmt.Sprintf("reviewer2_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewer1ID, "username": reviewer1ID, "is_active": true},
+				{"user_id": reviewer2ID, "username": reviewer2ID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Fairness PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/stats/fairness?team_name=%s", teamName), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Fairness struct {
+				Teams []struct {
+					TeamName    string   `json:"team_name"`
+					Assignments int      `json:"assignments"`
+					Gini        float64  `json:"gini"`
+					MaxMinRatio *float64 `json:"max_min_ratio"`
+					Outliers    []struct {
+						UserID string `json:"user_id"`
+					} `json:"outliers"`
+					Members []struct {
+						UserID      string `json:"user_id"`
+						Assignments int    `json:"assignments"`
+					} `json:"members"`
+				} `json:"teams"`
+			} `json:"fairness"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+
+		require.Len(t, result.Fairness.Teams, 1)
+		team := result.Fairness.Teams[0]
+		assert.Equal(t, teamName, team.TeamName)
+		assert.Equal(t, 2, team.Assignments)
+		assert.Nil(t, team.MaxMinRatio)
+		assert.InDelta(t, 1.0/3.0, team.Gini, 1e-9)
+		assert.Empty(t, team.Outliers)
+
+		counts := make(map[string]int)
+		for _, m := range team.Members {
+			counts[m.UserID] = m.Assignments
+		}
+		assert.Equal(t, map[string]int{authorID: 0, reviewer1ID: 1, reviewer2ID: 1}, counts)
+	})
+
+	t.Run("Reviewer counters follow assignments, merges and deletions", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("stats_counters_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		for i := 1; i <= 2; i++ {
+			resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr%d_%s", i, testID),
+				"pull_request_name": "Counters PR",
+				"author_id":         authorID,
+			})
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/merge", map[string]interface{}{"pull_request_id": fmt.Sprintf("pr1_%s", testID)})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/delete", map[string]interface{}{"pull_request_id": fmt.Sprintf("pr2_%s", testID)})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", "/users/getReviewsStats", nil)
+		require.NoError(t, err)
+
+		var stats struct {
+			ReviewsStatsList []struct {
+				UserID        string `json:"user_id"`
+				ReviewsNumber int    `json:"reviews_number"`
+				OpenReviews   int    `json:"open_reviews"`
+				MergedReviews int    `json:"merged_reviews"`
+			} `json:"reviews_stats_list"`
+		}
+		err = helpers.ParseResponse(resp, &stats)
+		require.NoError(t, err)
+
+		found := false
+		for _, rs := range stats.ReviewsStatsList {
+			if rs.UserID != reviewerID {
+				continue
+			}
+			found = true
+			assert.Equal(t, 1, rs.ReviewsNumber)
+			assert.Equal(t, 0, rs.OpenReviews)
+			assert.Equal(t, 1, rs.MergedReviews)
+		}
+		assert.True(t, found)
+
+		var result struct {
+			Counters struct {
+				Consistent bool          `json:"consistent"`
+				Rebuilt    bool          `json:"rebuilt"`
+				Drift      []interface{} `json:"drift"`
+			} `json:"counters"`
+		}
+
+		resp, err = helpers.MakeRequest("GET", "/stats/checkCounters", nil)
+		require.NoError(t, err)
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.True(t, result.Counters.Consistent)
+		assert.Empty(t, result.Counters.Drift)
+		assert.False(t, result.Counters.Rebuilt)
+
+		resp, err = helpers.MakeRequest("POST", "/stats/rebuildCounters", nil)
+		require.NoError(t, err)
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.True(t, result.Counters.Consistent)
+		assert.True(t, result.Counters.Rebuilt)
+	})
+
+	t.Run("Trend returns daily load snapshots for a user and a team", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("stats_trend_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Trend PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		type trendResponse struct {
+			Trend struct {
+				Points []struct {
+					Members          int `json:"members"`
+					OpenReviews      int `json:"open_reviews"`
+					MaxOpenReviews   int `json:"max_open_reviews"`
+					CompletedReviews int `json:"completed_reviews"`
+				} `json:"points"`
+			} `json:"trend"`
+		}
+		latest := func(query string) *trendResponse {
+			resp, err := helpers.MakeRequest("GET", "/stats/trend?"+query, nil)
+			if err != nil {
+				return nil
+			}
+			var got trendResponse
+			if err := helpers.ParseResponse(resp, &got); err != nil || len(got.Trend.Points) == 0 {
+				return nil
+			}
+			return &got
+		}
+
+		// the snapshot job runs every second in the e2e environment
+		require.Eventually(t, func() bool {
+			got := latest("user_id=" + reviewerID)
+			return got != nil && got.Trend.Points[len(got.Trend.Points)-1].OpenReviews == 1
+		}, 10*time.Second, 200*time.Millisecond)
+
+		got := latest("team_name=" + teamName)
+		require.NotNil(t, got)
+		point := got.Trend.Points[len(got.Trend.Points)-1]
+		assert.Equal(t, 2, point.Members)
+		assert.Equal(t, 1, point.OpenReviews)
+		assert.Equal(t, 1, point.MaxOpenReviews)
+		assert.Equal(t, 0, point.CompletedReviews)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/merge", map[string]interface{}{"pull_request_id": testID})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		require.Eventually(t, func() bool {
+			got := latest("team_name=" + teamName)
+			if got == nil {
+				return false
+			}
+			point := got.Trend.Points[len(got.Trend.Points)-1]
+			return point.OpenReviews == 0 && point.CompletedReviews == 1
+		}, 10*time.Second, 200*time.Millisecond)
+
+		renamed := "renamed_" + teamName
+		resp, err = helpers.MakeRequest("POST", "/team/update", map[string]interface{}{
+			"team_name":     teamName,
+			"new_team_name": renamed,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		assert.NotNil(t, latest("team_name="+renamed))
+	})
+
+	t.Run("Trend requires exactly one of user_id and team_name", func(t *testing.T) {
+		for _, query := range []string{"", "?user_id=u1&team_name=backend"} {
+			resp, err := helpers.MakeRequest("GET", "/stats/trend"+query, nil)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+
+	t.Run("Metrics endpoint exposes HTTP and domain metrics", func(t *testing.T) {
+		resp, err := helpers.MakeRequest("GET", "/stats/cycleTime", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = http.Get(helpers.GetAPIURL() + "/metrics")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		text := string(body)
+		assert.Contains(t, text, `pr_service_http_requests_total{method="GET",route="/api/v1/stats/cycleTime",status="200"}`)
+		assert.Contains(t, text, "pr_service_http_request_duration_seconds_bucket")
+		assert.Contains(t, text, "pr_service_open_pull_requests")
+		assert.Contains(t, text, "pr_service_pgxpool_total_conns")
+	})
+
+	t.Run("Fairness rejects malformed time range", func(t *testing.T) {
+		resp, err := helpers.MakeRequest("GET", "/stats/fairness?from=2025-02-01&to=2025-01-01", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("CycleTime rejects invalid group_by", func(t *testing.T) {
+		resp, err := helpers.MakeRequest("GET", "/stats/cycleTime?group_by=month", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
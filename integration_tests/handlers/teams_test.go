@@ -90,6 +90,290 @@ func TestTeamHandler_Integration(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 	})
 
+	t.Run("UpdateTeam renames team and DeleteTeam removes it", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("team_rename_%s_%d", t.Name(), timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		newTeamName := fmt.Sprintf("renamed_%s", testID)
+		userID := fmt.Sprintf("user_%s", testID)
+
+		team := map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{
+					"user_id":   userID,
+					"username":  userID,
+					"is_active": true,
+				},
+			},
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", team)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		updateReq := map[string]interface{}{
+			"team_name":     teamName,
+			"new_team_name": newTeamName,
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/team/update", updateReq)
+		require.NoError(t, err)
+
+		var result struct {
+			Team struct {
+				TeamName string                   `json:"team_name"`
+				Members  []map[string]interface{} `json:"members"`
+			} `json:"team"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, newTeamName, result.Team.TeamName)
+		assert.Len(t, result.Team.Members, 1)
+
+		deleteReq := map[string]interface{}{
+			"team_name": newTeamName,
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/team/delete", deleteReq)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		targetTeam := fmt.Sprintf("target_%s", testID)
+		resp, err = helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": targetTeam,
+			"members": []map[string]interface{}{
+				{"user_id": "lead_" + testID, "username": "lead_" + testID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		deleteReq["reassign_to"] = targetTeam
+		resp, err = helpers.MakeRequest("POST", "/team/delete", deleteReq)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/team/get?team_name=%s", newTeamName), nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Sub-team falls back to parent team reviewers", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("team_tree_%d", timestamp)
+		parentTeam := fmt.Sprintf("dept_%s", testID)
+		childTeam := fmt.Sprintf("squad_%s", testID)
+		leadID := fmt.Sprintf("lead_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": parentTeam,
+			"members": []map[string]interface{}{
+				{"user_id": leadID, "username": leadID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name":        childTeam,
+			"parent_team_name": parentTeam,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/team/get?team_name=%s&include_subtree=true", parentTeam), nil)
+		require.NoError(t, err)
+
+		var tree struct {
+			SubTeams []struct {
+				TeamName       string `json:"team_name"`
+				ParentTeamName string `json:"parent_team_name"`
+			} `json:"sub_teams"`
+		}
+		err = helpers.ParseResponse(resp, &tree)
+		require.NoError(t, err)
+		require.Len(t, tree.SubTeams, 1)
+		assert.Equal(t, childTeam, tree.SubTeams[0].TeamName)
+		assert.Equal(t, parentTeam, tree.SubTeams[0].ParentTeamName)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Tree PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		err = helpers.ParseResponse(resp, &created)
+		require.NoError(t, err)
+		assert.Equal(t, []string{leadID}, created.PR.AssignedReviewers)
+
+		resp, err = helpers.MakeRequest("GET", "/team/getReviewsStats", nil)
+		require.NoError(t, err)
+
+		var stats struct {
+			Teams []struct {
+				TeamName               string `json:"team_name"`
+				OpenReviewsNumber      int    `json:"open_reviews_number"`
+				TotalOpenReviewsNumber int    `json:"total_open_reviews_number"`
+			} `json:"teams"`
+		}
+		err = helpers.ParseResponse(resp, &stats)
+		require.NoError(t, err)
+
+		open := make(map[string][2]int)
+		for _, ts := range stats.Teams {
+			open[ts.TeamName] = [2]int{ts.OpenReviewsNumber, ts.TotalOpenReviewsNumber}
+		}
+		assert.Equal(t, [2]int{1, 1}, open[parentTeam])
+		assert.Equal(t, [2]int{0, 0}, open[childTeam])
+
+		resp, err = helpers.MakeRequest("POST", "/team/update", map[string]interface{}{
+			"team_name":        parentTeam,
+			"parent_team_name": childTeam,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/team/delete", map[string]interface{}{
+			"team_name":   parentTeam,
+			"reassign_to": childTeam,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("AddTeam rejects existing team while SyncTeam reports changes", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("team_sync_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		user1ID := fmt.Sprintf("user1_%s", testID)
+		user2ID := fmt.Sprintf("user2_%s", testID)
+
+		team := map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": user1ID, "username": user1ID, "is_active": true},
+			},
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", team)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/team/add", team)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		team["members"] = []map[string]interface{}{
+			{"user_id": user1ID, "username": user1ID, "is_active": false},
+			{"user_id": user2ID, "username": user2ID, "is_active": true},
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/team/sync", team)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Report struct {
+				Created bool `json:"created"`
+				Members []struct {
+					UserID string `json:"user_id"`
+					Status string `json:"status"`
+				} `json:"members"`
+			} `json:"report"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.False(t, result.Report.Created)
+
+		statuses := make(map[string]string)
+		for _, m := range result.Report.Members {
+			statuses[m.UserID] = m.Status
+		}
+		assert.Equal(t, "updated", statuses[user1ID])
+		assert.Equal(t, "added", statuses[user2ID])
+	})
+
+	t.Run("GetTeam includes member workload", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("team_workload_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Workload PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/team/get?team_name=%s&include=workload", teamName), nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var team struct {
+			Members []struct {
+				UserID   string `json:"user_id"`
+				Workload *struct {
+					OpenReviews                int      `json:"open_reviews"`
+					OldestOpenReviewAgeSeconds *float64 `json:"oldest_open_review_age_seconds"`
+					ReviewsLast30Days          int      `json:"reviews_last_30_days"`
+					IsAbsent                   bool     `json:"is_absent"`
+				} `json:"workload"`
+			} `json:"members"`
+		}
+		err = helpers.ParseResponse(resp, &team)
+		require.NoError(t, err)
+
+		require.Len(t, team.Members, 2)
+		for _, m := range team.Members {
+			require.NotNil(t, m.Workload)
+			assert.False(t, m.Workload.IsAbsent)
+			if m.UserID == reviewerID {
+				assert.Equal(t, 1, m.Workload.OpenReviews)
+				assert.Equal(t, 1, m.Workload.ReviewsLast30Days)
+				assert.NotNil(t, m.Workload.OldestOpenReviewAgeSeconds)
+			} else {
+				assert.Equal(t, 0, m.Workload.OpenReviews)
+				assert.Nil(t, m.Workload.OldestOpenReviewAgeSeconds)
+			}
+		}
+	})
+
 	t.Run("AddTeam returns error for invalid request", func(t *testing.T) {
 		invalidTeam := map[string]interface{}{
 			"team_name": "",
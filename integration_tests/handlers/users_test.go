@@ -115,6 +115,644 @@ func TestUsersHandler_Integration(t *testing.T) {
 		assert.NotNil(t, result["reviews_stats_list"])
 	})
 
+	t.Run("Transfer moves user and reassigns open reviews", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_transfer_%s_%d", t.Name(), timestamp)
+		oldTeam := fmt.Sprintf("team_old_%s", testID)
+		newTeam := fmt.Sprintf("team_new_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewer1ID := fmt.Sprintf("reviewer1_%s", testID)
+		reviewer2ID := fmt.Sprintf("reviewer2_%s", testID)
+		reviewer3ID := fmt.Sprintf("reviewer3_%s", testID)
+		prID := fmt.Sprintf("pr_%s", testID)
+
+		for _, team := range []map[string]interface{}{
+			{
+				"team_name": oldTeam,
+				"members": []map[string]interface{}{
+					{"user_id": authorID, "username": authorID, "is_active": true},
+					{"user_id": reviewer1ID, "username": reviewer1ID, "is_active": true},
+					{"user_id": reviewer2ID, "username": reviewer2ID, "is_active": true},
+					{"user_id": reviewer3ID, "username": reviewer3ID, "is_active": true},
+				},
+			},
+			{
+				"team_name": newTeam,
+				"members":   []map[string]interface{}{},
+			},
+		} {
+			resp, err := helpers.MakeRequest("POST", "/team/add", team)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		pr := map[string]interface{}{
+			"pull_request_id":   prID,
+			"pull_request_name": "Test PR",
+			"author_id":         authorID,
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/pullRequest/create", pr)
+		require.NoError(t, err)
+
+		var created struct {
+			PR struct {
+				Assigned []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		err = helpers.ParseResponse(resp, &created)
+		require.NoError(t, err)
+		require.NotEmpty(t, created.PR.Assigned)
+		leavingID := created.PR.Assigned[0]
+
+		transferReq := map[string]interface{}{
+			"user_id":      leavingID,
+			"team_name":    newTeam,
+			"open_reviews": "reassign",
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/users/transfer", transferReq)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			User struct {
+				TeamName string `json:"team_name"`
+			} `json:"user"`
+			HandedOver []map[string]interface{} `json:"handed_over"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, newTeam, result.User.TeamName)
+		assert.Len(t, result.HandedOver, 1)
+	})
+
+	t.Run("Team stats keep reviews in the team of assignment time", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_history_%d", timestamp)
+		oldTeam := fmt.Sprintf("team_old_%s", testID)
+		newTeam := fmt.Sprintf("team_new_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewerID := fmt.Sprintf("reviewer_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": oldTeam,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "History PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": newTeam,
+			"members": []map[string]interface{}{
+				{"user_id": reviewerID, "username": reviewerID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = helpers.MakeRequest("GET", "/team/getReviewsStats", nil)
+		require.NoError(t, err)
+
+		var result struct {
+			Teams []struct {
+				TeamName      string `json:"team_name"`
+				ReviewsNumber int    `json:"reviews_number"`
+			} `json:"teams"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+
+		reviews := make(map[string]int)
+		for _, ts := range result.Teams {
+			reviews[ts.TeamName] = ts.ReviewsNumber
+		}
+		assert.Equal(t, 1, reviews[oldTeam])
+		assert.Equal(t, 0, reviews[newTeam])
+	})
+
+	t.Run("SetTeams adds secondary team used for reviewer selection", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_teams_%d", timestamp)
+		teamA := fmt.Sprintf("team_a_%s", testID)
+		teamB := fmt.Sprintf("team_b_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		helperID := fmt.Sprintf("helper_%s", testID)
+
+		for _, team := range []map[string]interface{}{
+			{
+				"team_name": teamA,
+				"members": []map[string]interface{}{
+					{"user_id": authorID, "username": authorID, "is_active": true},
+				},
+			},
+			{
+				"team_name": teamB,
+				"members": []map[string]interface{}{
+					{"user_id": helperID, "username": helperID, "is_active": true},
+				},
+			},
+		} {
+			resp, err := helpers.MakeRequest("POST", "/team/add", team)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/users/setTeams", map[string]interface{}{
+			"user_id": helperID,
+			"teams": []map[string]interface{}{
+				{"team_name": teamB, "is_primary": true},
+				{"team_name": teamA, "review_weight": 0.5},
+			},
+		})
+		require.NoError(t, err)
+
+		var result struct {
+			User struct {
+				TeamName string `json:"team_name"`
+				Teams    []struct {
+					TeamName  string `json:"team_name"`
+					IsPrimary bool   `json:"is_primary"`
+				} `json:"teams"`
+			} `json:"user"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, teamB, result.User.TeamName)
+		assert.Len(t, result.User.Teams, 2)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Multi-team PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		err = helpers.ParseResponse(resp, &created)
+		require.NoError(t, err)
+		assert.Equal(t, []string{helperID}, created.PR.AssignedReviewers)
+	})
+
+	t.Run("Get, List and SetRole read and filter users", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("users_list_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+
+		members := []map[string]interface{}{}
+		for i := 1; i <= 3; i++ {
+			userID := fmt.Sprintf("user%d_%s", i, testID)
+			members = append(members, map[string]interface{}{"user_id": userID, "username": fmt.Sprintf("search_%d_%d", timestamp, i), "is_active": i != 3})
+		}
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/setRole", map[string]interface{}{"user_id": members[0]["user_id"], "role": "lead"})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/users/get?user_id=%s", members[0]["user_id"]), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var got struct {
+			User struct {
+				TeamName string `json:"team_name"`
+				Role     string `json:"role"`
+				Teams    []struct {
+					TeamName string `json:"team_name"`
+				} `json:"teams"`
+			} `json:"user"`
+		}
+		err = helpers.ParseResponse(resp, &got)
+		require.NoError(t, err)
+		assert.Equal(t, teamName, got.User.TeamName)
+		assert.Equal(t, "lead", got.User.Role)
+		assert.Len(t, got.User.Teams, 1)
+
+		type page struct {
+			Users []struct {
+				UserID string `json:"user_id"`
+			} `json:"users"`
+			NextCursor string `json:"next_cursor"`
+		}
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/users/list?team_name=%s&is_active=true&limit=1", teamName), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var first page
+		err = helpers.ParseResponse(resp, &first)
+		require.NoError(t, err)
+		require.Len(t, first.Users, 1)
+		assert.Equal(t, members[0]["user_id"], first.Users[0].UserID)
+		require.NotEmpty(t, first.NextCursor)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/users/list?team_name=%s&is_active=true&limit=1&cursor=%s", teamName, first.NextCursor), nil)
+		require.NoError(t, err)
+
+		var second page
+		err = helpers.ParseResponse(resp, &second)
+		require.NoError(t, err)
+		require.Len(t, second.Users, 1)
+		assert.Equal(t, members[1]["user_id"], second.Users[0].UserID)
+		assert.Empty(t, second.NextCursor)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/users/list?search=search_%d&role=lead", timestamp), nil)
+		require.NoError(t, err)
+
+		var leads page
+		err = helpers.ParseResponse(resp, &leads)
+		require.NoError(t, err)
+		require.Len(t, leads.Users, 1)
+		assert.Equal(t, members[0]["user_id"], leads.Users[0].UserID)
+
+		resp, err = helpers.MakeRequest("GET", "/users/get?user_id=nonexistent_user", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Offboard anonymises user and keeps review stats", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_offboard_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+
+		members := []map[string]interface{}{{"user_id": authorID, "username": authorID, "is_active": true}}
+		for i := 1; i <= 3; i++ {
+			userID := fmt.Sprintf("reviewer%d_%s", i, testID)
+			members = append(members, map[string]interface{}{"user_id": userID, "username": userID, "is_active": true})
+		}
+		team := map[string]interface{}{"team_name": teamName, "members": members}
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", team)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   testID,
+			"pull_request_name": "Offboarding PR",
+			"author_id":         authorID,
+		})
+		require.NoError(t, err)
+
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		err = helpers.ParseResponse(resp, &created)
+		require.NoError(t, err)
+		require.Len(t, created.PR.AssignedReviewers, 2)
+		leaverID := created.PR.AssignedReviewers[0]
+
+		resp, err = helpers.MakeRequest("POST", "/users/offboard", map[string]interface{}{
+			"user_id":      leaverID,
+			"open_reviews": "handover",
+			"handover_to":  fmt.Sprintf("missing_%s", testID),
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/offboard", map[string]interface{}{"user_id": leaverID})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var offboarded struct {
+			User struct {
+				Username     string  `json:"username"`
+				IsActive     bool    `json:"is_active"`
+				OffboardedAt *string `json:"offboarded_at"`
+			} `json:"user"`
+			Handovers []struct {
+				PullRequestID string `json:"pull_request_id"`
+				ReplacedBy    string `json:"replaced_by"`
+			} `json:"handovers"`
+		}
+		err = helpers.ParseResponse(resp, &offboarded)
+		require.NoError(t, err)
+		assert.NotEqual(t, leaverID, offboarded.User.Username)
+		assert.False(t, offboarded.User.IsActive)
+		assert.NotNil(t, offboarded.User.OffboardedAt)
+		require.Len(t, offboarded.Handovers, 1)
+		assert.NotEqual(t, leaverID, offboarded.Handovers[0].ReplacedBy)
+
+		resp, err = helpers.MakeRequest("GET", "/users/getReviewsStats", nil)
+		require.NoError(t, err)
+
+		var stats struct {
+			ReviewsStatsList []struct {
+				UserID        string `json:"user_id"`
+				Username      string `json:"username"`
+				ReviewsNumber int    `json:"reviews_number"`
+			} `json:"reviews_stats_list"`
+		}
+		err = helpers.ParseResponse(resp, &stats)
+		require.NoError(t, err)
+
+		found := false
+		for _, s := range stats.ReviewsStatsList {
+			if s.UserID == leaverID {
+				found = true
+				assert.Equal(t, offboarded.User.Username, s.Username)
+				assert.Equal(t, 1, s.ReviewsNumber)
+			}
+		}
+		assert.True(t, found)
+
+		resp, err = helpers.MakeRequest("POST", "/team/sync", team)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/setIsActive", map[string]interface{}{"user_id": leaverID, "is_active": true})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/offboard", map[string]interface{}{"user_id": leaverID})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("UpdateProfile changes contacts and notification preferences", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_profile_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		userID := fmt.Sprintf("user_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members":   []map[string]interface{}{{"user_id": userID, "username": userID, "is_active": true}},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/updateProfile", map[string]interface{}{
+			"user_id":            userID,
+			"email":              "user@example.com",
+			"chat_handle":        "@user",
+			"preferred_language": "ru",
+			"notifications":      map[string]interface{}{"merged": false},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			User struct {
+				Profile struct {
+					Email             string          `json:"email"`
+					ChatHandle        string          `json:"chat_handle"`
+					PreferredLanguage string          `json:"preferred_language"`
+					Notifications     map[string]bool `json:"notifications"`
+				} `json:"profile"`
+			} `json:"user"`
+		}
+		err = helpers.ParseResponse(resp, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "user@example.com", result.User.Profile.Email)
+		assert.Equal(t, "@user", result.User.Profile.ChatHandle)
+		assert.Equal(t, "ru", result.User.Profile.PreferredLanguage)
+		assert.Equal(t, map[string]bool{"assigned": true, "reassigned": true, "overdue": true, "merged": false}, result.User.Profile.Notifications)
+
+		resp, err = helpers.MakeRequest("POST", "/users/updateProfile", map[string]interface{}{
+			"user_id": userID,
+			"email":   "not-an-email",
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("SetIsActive with until reactivates user automatically", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_until_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		user1ID := fmt.Sprintf("user1_%s", testID)
+		user2ID := fmt.Sprintf("user2_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": user1ID, "username": user1ID, "is_active": true},
+				{"user_id": user2ID, "username": user2ID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		type userResponse struct {
+			User struct {
+				IsActive      bool    `json:"is_active"`
+				InactiveUntil *string `json:"inactive_until"`
+			} `json:"user"`
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/users/setIsActive", map[string]interface{}{
+			"user_id":   user1ID,
+			"is_active": false,
+			"until":     time.Now().Add(2 * time.Second).UTC().Format(time.RFC3339Nano),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var deactivated userResponse
+		err = helpers.ParseResponse(resp, &deactivated)
+		require.NoError(t, err)
+		assert.False(t, deactivated.User.IsActive)
+		assert.NotNil(t, deactivated.User.InactiveUntil)
+
+		resp, err = helpers.MakeRequest("POST", "/users/setIsActive", map[string]interface{}{
+			"user_id":   user2ID,
+			"is_active": false,
+			"until":     time.Now().Add(2 * time.Second).UTC().Format(time.RFC3339Nano),
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/cancelReactivation", map[string]interface{}{"user_id": user2ID})
+		require.NoError(t, err)
+
+		var cancelled userResponse
+		err = helpers.ParseResponse(resp, &cancelled)
+		require.NoError(t, err)
+		assert.Nil(t, cancelled.User.InactiveUntil)
+
+		require.Eventually(t, func() bool {
+			resp, err := helpers.MakeRequest("GET", fmt.Sprintf("/users/get?user_id=%s", user1ID), nil)
+			if err != nil {
+				return false
+			}
+			var got userResponse
+			if err := helpers.ParseResponse(resp, &got); err != nil {
+				return false
+			}
+			return got.User.IsActive && got.User.InactiveUntil == nil
+		}, 10*time.Second, 500*time.Millisecond)
+
+		resp, err = helpers.MakeRequest("GET", fmt.Sprintf("/users/get?user_id=%s", user2ID), nil)
+		require.NoError(t, err)
+
+		var stillInactive userResponse
+		err = helpers.ParseResponse(resp, &stillInactive)
+		require.NoError(t, err)
+		assert.False(t, stillInactive.User.IsActive)
+
+		resp, err = helpers.MakeRequest("POST", "/users/setIsActive", map[string]interface{}{
+			"user_id":   user2ID,
+			"is_active": true,
+			"until":     time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("GetReviewsStats filters by team, status and time range", func(t *testing.T) {
+		timestamp := time.Now().UnixNano()
+		testID := fmt.Sprintf("user_stats_filter_%d", timestamp)
+		teamName := fmt.Sprintf("team_%s", testID)
+		authorID := fmt.Sprintf("author_%s", testID)
+		reviewer1ID := fmt.Sprintf("reviewer1_%s", testID)
+		reviewer2ID := fmt.Sprintf("reviewer2_%s", testID)
+
+		resp, err := helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": authorID, "username": authorID, "is_active": true},
+				{"user_id": reviewer1ID, "username": reviewer1ID, "is_active": true},
+				{"user_id": reviewer2ID, "username": reviewer2ID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		for i := 1; i <= 2; i++ {
+			resp, err = helpers.MakeRequest("POST", "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr%d_%s", i, testID),
+				"pull_request_name": "Stats PR",
+				"author_id":         authorID,
+			})
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+
+		resp, err = helpers.MakeRequest("POST", "/pullRequest/merge", map[string]interface{}{"pull_request_id": fmt.Sprintf("pr1_%s", testID)})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		type statsResponse struct {
+			ReviewsStatsList []struct {
+				UserID        string `json:"user_id"`
+				TeamName      string `json:"team_name"`
+				IsActive      bool   `json:"is_active"`
+				ReviewsNumber int    `json:"reviews_number"`
+				OpenReviews   int    `json:"open_reviews"`
+				MergedReviews int    `json:"merged_reviews"`
+			} `json:"reviews_stats_list"`
+		}
+
+		getStats := func(query string) statsResponse {
+			resp, err := helpers.MakeRequest("GET", "/users/getReviewsStats?"+query, nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var result statsResponse
+			err = helpers.ParseResponse(resp, &result)
+			require.NoError(t, err)
+			return result
+		}
+
+		// a secondary member of the team is neither counted nor listed for it
+		helperID := fmt.Sprintf("helper_%s", testID)
+		resp, err = helpers.MakeRequest("POST", "/team/add", map[string]interface{}{
+			"team_name": "other_" + teamName,
+			"members": []map[string]interface{}{
+				{"user_id": helperID, "username": helperID, "is_active": true},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = helpers.MakeRequest("POST", "/users/setTeams", map[string]interface{}{
+			"user_id": helperID,
+			"teams": []map[string]interface{}{
+				{"team_name": "other_" + teamName, "is_primary": true},
+				{"team_name": teamName},
+			},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		stats := getStats("team_name=" + teamName)
+		require.Len(t, stats.ReviewsStatsList, 3)
+		for _, s := range stats.ReviewsStatsList {
+			assert.Equal(t, teamName, s.TeamName)
+			assert.True(t, s.IsActive)
+			if s.UserID == authorID {
+				assert.Equal(t, 0, s.ReviewsNumber)
+				continue
+			}
+			assert.Equal(t, 2, s.ReviewsNumber)
+			assert.Equal(t, 1, s.OpenReviews)
+			assert.Equal(t, 1, s.MergedReviews)
+		}
+
+		stats = getStats("team_name=" + teamName + "&status=MERGED")
+		require.Len(t, stats.ReviewsStatsList, 3)
+		for _, s := range stats.ReviewsStatsList {
+			if s.UserID != authorID {
+				assert.Equal(t, 1, s.ReviewsNumber)
+			}
+		}
+
+		tomorrow := time.Now().Add(24 * time.Hour).UTC().Format(time.DateOnly)
+		stats = getStats("team_name=" + teamName + "&from=" + tomorrow)
+		require.Len(t, stats.ReviewsStatsList, 3)
+		for _, s := range stats.ReviewsStatsList {
+			assert.Equal(t, 0, s.ReviewsNumber)
+		}
+
+		resp, err = helpers.MakeRequest("GET", "/users/getReviewsStats?from=yesterday", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("SetIsActive returns 404 for non-existent user", func(t *testing.T) {
 		setActiveReq := map[string]interface{}{
 			"user_id":   "nonexistent",
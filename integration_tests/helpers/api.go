@@ -17,6 +17,10 @@ func GetAPIURL() string {
 }
 
 func MakeRequest(method, endpoint string, body interface{}) (*http.Response, error) {
+	return MakeRequestWithHeaders(method, endpoint, body, nil)
+}
+
+func MakeRequestWithHeaders(method, endpoint string, body interface{}, headers map[string]string) (*http.Response, error) {
 	url := fmt.Sprintf("%s/api/v1%s", GetAPIURL(), endpoint)
 
 	var reqBody []byte
@@ -37,6 +41,10 @@ func MakeRequest(method, endpoint string, body interface{}) (*http.Response, err
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -63,3 +71,21 @@ func ParseResponse(resp *http.Response, target interface{}) error {
 
 	return nil
 }
+
+func MakeRawRequest(method, endpoint, contentType string, body []byte) (*http.Response, error) {
+	url := fmt.Sprintf("%s/api/v1%s", GetAPIURL(), endpoint)
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	return resp, nil
+}
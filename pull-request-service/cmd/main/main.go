@@ -1,9 +1,15 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"pull-request-service/internal/app"
+	"pull-request-service/internal/models"
 )
 
 func main() {
@@ -13,7 +19,44 @@ func main() {
 	}
 
 	application := app.NewApp(config)
+
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		runImport(application, os.Args[2:])
+		return
+	}
+
 	if err := application.Run(); err != nil {
 		log.Fatalf("Application error: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+// runImport handles `import -file org.yaml [-format yaml|csv] [-dry-run]` and prints the diff as JSON.
+func runImport(application *app.App, args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("file", "", "path to the org chart (YAML or CSV)")
+	format := fs.String("format", "", "org chart format: yaml or csv (defaults to the file extension)")
+	dryRun := fs.Bool("dry-run", false, "only print the diff without applying it")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		log.Fatal("import: -file is required")
+	}
+
+	if *format == "" {
+		*format = string(models.OrgChartYAML)
+		if strings.EqualFold(filepath.Ext(*file), ".csv") {
+			*format = string(models.OrgChartCSV)
+		}
+	}
+
+	diff, err := application.ImportOrgChart(*file, models.OrgChartFormat(*format), *dryRun)
+	if err != nil {
+		log.Fatalf("Import error: %v", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(diff); err != nil {
+		log.Fatalf("Failed to print diff: %v", err)
+	}
+}
@@ -7,14 +7,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"pull-request-service/internal/delivery/http/dashboard"
 	"pull-request-service/internal/delivery/http/handlers"
 	"pull-request-service/internal/delivery/http/middleware"
 	"pull-request-service/internal/delivery/http/routes"
 	"pull-request-service/internal/delivery/http/validation"
+	"pull-request-service/internal/metrics"
+	"pull-request-service/internal/models"
 	"pull-request-service/internal/repository"
+	"pull-request-service/internal/scheduler"
 	"pull-request-service/internal/service"
 	database "pull-request-service/pkg/db"
 )
@@ -49,15 +54,7 @@ func (a *App) Run() error {
 		Level: logLevel,
 	}))
 
-	postgres, err := database.NewPostgresWithConfig(ctx, database.PostgresConfig{
-		Host:     a.config.Postgres.Host,
-		Port:     a.config.Postgres.Port,
-		Username: a.config.Postgres.Username,
-		Password: a.config.Postgres.Password,
-		DBName:   a.config.Postgres.DBName,
-		SSLMode:  a.config.Postgres.SSLMode,
-		Timeout:  a.config.Postgres.TimeOut,
-	})
+	postgres, err := a.connectPostgres(ctx)
 	if err != nil {
 		logger.Error("postgres init failed", "err", err)
 		return fmt.Errorf("failed to initialize postgres: %w", err)
@@ -70,13 +67,30 @@ func (a *App) Run() error {
 	usersRepository := repository.NewUsersRepository(postgres.Pool)
 	pullRequestsRepository := repository.NewPullRequestRepository(postgres.Pool)
 	reviewRepository := repository.NewReviewRepository(postgres.Pool)
+	historyRepository := repository.NewHistoryRepository(postgres.Pool)
+	statsRepository := repository.NewStatsRepository(postgres.Pool)
+	exportRepository := repository.NewExportRepository(postgres.Pool)
+
+	appMetrics := metrics.New(postgres.Pool, statsRepository)
 
-	teamsService := service.NewTeamService(teamsRepository, usersRepository, txManager)
-	usersService := service.NewUsersService(usersRepository, reviewRepository, txManager)
+	teamsService := service.NewTeamService(teamsRepository, usersRepository, reviewRepository, txManager)
 	pullRequestService := service.NewPullRequestService(
 		pullRequestsRepository,
 		reviewRepository,
 		teamsRepository,
+		historyRepository,
+		txManager,
+		a.config.PullRequest.DeleteMode,
+		appMetrics,
+	)
+	orgService := service.NewOrgService(teamsRepository, usersRepository, reviewRepository, pullRequestService, txManager)
+	statsService := service.NewStatsService(statsRepository, txManager)
+	exportService := service.NewExportService(exportRepository)
+	usersService := service.NewUsersService(
+		usersRepository,
+		reviewRepository,
+		teamsRepository,
+		pullRequestService,
 		txManager,
 	)
 
@@ -85,20 +99,41 @@ func (a *App) Run() error {
 	pullRequestHandler := handlers.NewPullRequestHandler(pullRequestService, logger, validator)
 	teamsHandler := handlers.NewTeamHandler(teamsService, logger, validator)
 	usersHandler := handlers.NewUsersHandler(usersService, logger, validator)
+	orgHandler := handlers.NewOrgHandler(orgService, logger)
+	statsHandler := handlers.NewStatsHandler(statsService, logger, validator)
+	exportHandler := handlers.NewExportHandler(exportService, logger, validator)
+	dashboardHandler := dashboard.NewHandler(teamsService, statsService, logger)
 
 	loggingMw := middleware.LoggingMiddleware(logger)
-	api := routes.SetupMainRouter(loggingMw)
+	metricsMw := middleware.MetricsMiddleware(appMetrics)
+	router, api := routes.SetupMainRouter(loggingMw, metricsMw)
 
 	routes.SetupPullRequestRoutes(api, pullRequestHandler)
 	routes.SetupTeamRoutes(api, teamsHandler)
 	routes.SetupUsersRoutes(api, usersHandler)
+	routes.SetupOrgRoutes(api, orgHandler)
+	routes.SetupStatsRoutes(api, statsHandler)
+	routes.SetupExportRoutes(api, exportHandler)
+	routes.SetupMetricsRoutes(router, appMetrics.Handler())
+	routes.SetupDashboardRoutes(router, dashboardHandler, metricsMw, loggingMw)
 
 	serverAddr := fmt.Sprintf("%s:%d", a.config.Server.Host, a.config.Server.Port)
 	srv := http.Server{
 		Addr:    serverAddr,
-		Handler: api,
+		Handler: router,
 	}
 
+	var jobs sync.WaitGroup
+	jobs.Add(2)
+	go func() {
+		defer jobs.Done()
+		scheduler.RunReactivation(ctx, usersService, time.Duration(a.config.Scheduler.ReactivationInterval)*time.Second, logger)
+	}()
+	go func() {
+		defer jobs.Done()
+		scheduler.RunSnapshots(ctx, statsService, time.Duration(a.config.Scheduler.SnapshotInterval)*time.Second, logger)
+	}()
+
 	logger.Info("server started", "addr", serverAddr)
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -117,9 +152,67 @@ func (a *App) Run() error {
 	)
 	defer shutdownCancel()
 
-	if err := srv.Shutdown(shutdownCtx); err != nil {
+	err = srv.Shutdown(shutdownCtx)
+
+	// Background jobs must be stopped before the deferred pool close.
+	cancel()
+	jobs.Wait()
+
+	if err != nil {
 		logger.Error("server shutdown failed", "err", err)
 		return err
 	}
 	return nil
 }
+
+func (a *App) connectPostgres(ctx context.Context) (*database.Postgres, error) {
+	return database.NewPostgresWithConfig(ctx, database.PostgresConfig{
+		Host:     a.config.Postgres.Host,
+		Port:     a.config.Postgres.Port,
+		Username: a.config.Postgres.Username,
+		Password: a.config.Postgres.Password,
+		DBName:   a.config.Postgres.DBName,
+		SSLMode:  a.config.Postgres.SSLMode,
+		Timeout:  a.config.Postgres.TimeOut,
+	})
+}
+
+// ImportOrgChart applies (or previews, with dryRun) an org chart file and returns the diff.
+func (a *App) ImportOrgChart(path string, format models.OrgChartFormat, dryRun bool) (*models.OrgDiff, error) {
+	ctx := context.Background()
+
+	postgres, err := a.connectPostgres(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize postgres: %w", err)
+	}
+	defer postgres.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening org chart: %w", err)
+	}
+	defer f.Close()
+
+	txManager := database.NewTransactionManager(postgres.Pool)
+	teamsRepository := repository.NewTeamsRepository(postgres.Pool)
+	reviewRepository := repository.NewReviewRepository(postgres.Pool)
+
+	pullRequestService := service.NewPullRequestService(
+		repository.NewPullRequestRepository(postgres.Pool),
+		reviewRepository,
+		teamsRepository,
+		repository.NewHistoryRepository(postgres.Pool),
+		txManager,
+		a.config.PullRequest.DeleteMode,
+		nil,
+	)
+	orgService := service.NewOrgService(
+		teamsRepository,
+		repository.NewUsersRepository(postgres.Pool),
+		reviewRepository,
+		pullRequestService,
+		txManager,
+	)
+
+	return orgService.Import(ctx, f, format, dryRun)
+}
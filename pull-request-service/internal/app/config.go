@@ -1,14 +1,19 @@
 package app
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+
+	"pull-request-service/internal/models"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Postgres PostgresConfig
-	LogLevel string
+	Server      ServerConfig
+	Postgres    PostgresConfig
+	PullRequest PullRequestConfig
+	Scheduler   SchedulerConfig
+	LogLevel    string
 }
 
 type ServerConfig struct {
@@ -27,9 +32,33 @@ type PostgresConfig struct {
 	TimeOut  int64
 }
 
+type PullRequestConfig struct {
+	DeleteMode models.DeleteMode
+}
+
+type SchedulerConfig struct {
+	ReactivationInterval int
+	SnapshotInterval     int
+}
+
 func LoadConfig() (*Config, error) {
-	config := &Config{}
+	config := &Config{
+		PullRequest: PullRequestConfig{
+			DeleteMode: models.DeleteModeSoft,
+		},
+		Scheduler: SchedulerConfig{
+			ReactivationInterval: 60,
+			SnapshotInterval:     3600,
+		},
+	}
 	loadEnvVars(config)
+
+	switch config.PullRequest.DeleteMode {
+	case models.DeleteModeSoft, models.DeleteModeHard:
+	default:
+		return nil, fmt.Errorf("invalid PR_DELETE_MODE %q: must be soft or hard", config.PullRequest.DeleteMode)
+	}
+
 	return config, nil
 }
 
@@ -50,6 +79,22 @@ func loadEnvVars(config *Config) {
 		}
 	}
 
+	if envVal := os.Getenv("PR_DELETE_MODE"); envVal != "" {
+		config.PullRequest.DeleteMode = models.DeleteMode(envVal)
+	}
+
+	if envVal := os.Getenv("PR_REACTIVATION_INTERVAL"); envVal != "" {
+		if interval, err := strconv.Atoi(envVal); err == nil && interval > 0 {
+			config.Scheduler.ReactivationInterval = interval
+		}
+	}
+
+	if envVal := os.Getenv("PR_SNAPSHOT_INTERVAL"); envVal != "" {
+		if interval, err := strconv.Atoi(envVal); err == nil && interval > 0 {
+			config.Scheduler.SnapshotInterval = interval
+		}
+	}
+
 	if envVal := os.Getenv("POSTGRES_HOST"); envVal != "" {
 		config.Postgres.Host = envVal
 	}
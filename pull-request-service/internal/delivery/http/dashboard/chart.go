@@ -0,0 +1,103 @@
+package dashboard
+
+import (
+	"fmt"
+	"html/template"
+	"time"
+
+	"pull-request-service/internal/models"
+)
+
+const (
+	chartSlotWidth  = 20
+	chartBarWidth   = 8
+	chartPlotHeight = 120
+	chartLabelEvery = 7
+)
+
+type chartBar struct {
+	X, Y, Height int
+	Title        string
+}
+
+type chartLabel struct {
+	X    int
+	Text string
+}
+
+// activityChart is a pre-computed SVG bar chart, one slot per day with assigned and merged bars side by side.
+type activityChart struct {
+	Width, Height, PlotHeight int
+	BarWidth                  int
+	Max                       int
+	Assigned, Merged          []chartBar
+	Labels                    []chartLabel
+}
+
+func newActivityChart(days []*models.DailyActivity) *activityChart {
+	c := &activityChart{
+		Width:      len(days) * chartSlotWidth,
+		PlotHeight: chartPlotHeight,
+		Height:     chartPlotHeight + 16,
+		BarWidth:   chartBarWidth,
+	}
+
+	for _, d := range days {
+		c.Max = max(c.Max, d.Assigned, d.Merged)
+	}
+
+	for i, d := range days {
+		x := i * chartSlotWidth
+		day := d.Day.Format(time.DateOnly)
+
+		c.Assigned = append(c.Assigned, c.bar(x+1, d.Assigned, fmt.Sprintf("%s: %d reviews assigned", day, d.Assigned)))
+		c.Merged = append(c.Merged, c.bar(x+1+chartBarWidth, d.Merged, fmt.Sprintf("%s: %d reviews on merged PRs", day, d.Merged)))
+
+		if (len(days)-1-i)%chartLabelEvery == 0 {
+			c.Labels = append(c.Labels, chartLabel{X: x + chartSlotWidth/2, Text: d.Day.Format("Jan 2")})
+		}
+	}
+
+	return c
+}
+
+func (c *activityChart) bar(x, value int, title string) chartBar {
+	height := 0
+	if c.Max > 0 {
+		height = value * c.PlotHeight / c.Max
+	}
+	// keep a sliver visible so that non-zero days are distinguishable from empty ones
+	if value > 0 && height == 0 {
+		height = 1
+	}
+
+	return chartBar{X: x, Y: c.PlotHeight - height, Height: height, Title: title}
+}
+
+var templateFuncs = template.FuncMap{
+	"datetime": func(t *time.Time) string {
+		if t == nil {
+			return "—"
+		}
+		return t.UTC().Format("2006-01-02 15:04")
+	},
+	"age": func(seconds *float64) string {
+		if seconds == nil {
+			return "—"
+		}
+		return formatAge(*seconds)
+	},
+	"waiting": formatAge,
+}
+
+func formatAge(seconds float64) string {
+	d := time.Duration(seconds) * time.Second
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd %dh", int(d.Hours())/24, int(d.Hours())%24)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh %dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+}
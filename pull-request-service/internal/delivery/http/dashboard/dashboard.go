@@ -0,0 +1,196 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pull-request-service/internal/models"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+//go:embed static
+var staticFS embed.FS
+
+const (
+	chartDays           = 30
+	defaultOverdueHours = 24
+	maxOverdueHours     = 24 * 30
+)
+
+type TeamService interface {
+	GetTeam(ctx context.Context, teamName string) (*models.Team, error)
+	AddWorkload(ctx context.Context, team *models.Team) error
+	GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error)
+}
+
+type StatsService interface {
+	GetOpenPullRequests(ctx context.Context, teamName string) ([]*models.OpenPullRequest, error)
+	GetOverdueReviews(ctx context.Context, teamName string, olderThan time.Duration) ([]*models.OverdueReview, error)
+	GetDailyActivity(ctx context.Context, teamName string, days int) ([]*models.DailyActivity, error)
+}
+
+// Handler serves a server-rendered dashboard on top of the same services as the JSON API.
+type Handler struct {
+	teamService  TeamService
+	statsService StatsService
+	logger       *slog.Logger
+	templates    *template.Template
+}
+
+func NewHandler(teams TeamService, stats StatsService, logger *slog.Logger) *Handler {
+	return &Handler{
+		teamService:  teams,
+		statsService: stats,
+		logger:       logger,
+		templates:    template.Must(template.New("").Funcs(templateFuncs).ParseFS(templateFS, "templates/*.html")),
+	}
+}
+
+// Static serves the embedded stylesheet; mount it under /dashboard/static/ with the prefix stripped.
+func (h *Handler) Static() http.Handler {
+	static, err := fs.Sub(staticFS, "static")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServerFS(static)
+}
+
+type indexPage struct {
+	Teams       []*models.TeamReviewStats
+	GeneratedAt time.Time
+}
+
+func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
+	teams, err := h.teamService.GetTeamReviewsStats(r.Context())
+	if err != nil {
+		h.logger.Error("dashboard: get teams failed", "err", err)
+		http.Error(w, "failed to load teams", http.StatusInternalServerError)
+		return
+	}
+
+	h.render(w, "index", indexPage{Teams: teams, GeneratedAt: time.Now().UTC()})
+}
+
+type memberRow struct {
+	models.TeamMember
+	LoadPercent int
+}
+
+type teamPage struct {
+	TeamName       string
+	ParentTeamName string
+	Members        []memberRow
+	OpenPRs        []*models.OpenPullRequest
+	Overdue        []*models.OverdueReview
+	OverdueHours   int
+	Chart          *activityChart
+	GeneratedAt    time.Time
+}
+
+func (h *Handler) Team(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		http.Error(w, "team_name is required", http.StatusBadRequest)
+		return
+	}
+
+	overdueHours := defaultOverdueHours
+	if v := r.URL.Query().Get("overdue_hours"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxOverdueHours {
+			http.Error(w, fmt.Sprintf("overdue_hours must be between 1 and %d", maxOverdueHours), http.StatusBadRequest)
+			return
+		}
+		overdueHours = n
+	}
+
+	ctx := r.Context()
+
+	team, err := h.teamService.GetTeam(ctx, teamName)
+	if err != nil {
+		http.Error(w, "team not found", http.StatusNotFound)
+		return
+	}
+	if err := h.teamService.AddWorkload(ctx, team); err != nil {
+		h.serverError(w, teamName, "workload", err)
+		return
+	}
+
+	openPRs, err := h.statsService.GetOpenPullRequests(ctx, teamName)
+	if err != nil {
+		h.serverError(w, teamName, "open PRs", err)
+		return
+	}
+
+	overdue, err := h.statsService.GetOverdueReviews(ctx, teamName, time.Duration(overdueHours)*time.Hour)
+	if err != nil {
+		h.serverError(w, teamName, "overdue reviews", err)
+		return
+	}
+
+	activity, err := h.statsService.GetDailyActivity(ctx, teamName, chartDays)
+	if err != nil {
+		h.serverError(w, teamName, "daily activity", err)
+		return
+	}
+
+	h.render(w, "team", teamPage{
+		TeamName:       team.TeamName,
+		ParentTeamName: team.ParentTeamName,
+		Members:        memberRows(team.Members),
+		OpenPRs:        openPRs,
+		Overdue:        overdue,
+		OverdueHours:   overdueHours,
+		Chart:          newActivityChart(activity),
+		GeneratedAt:    time.Now().UTC(),
+	})
+}
+
+// memberRows scales each member's open reviews against the busiest member for the load bars.
+func memberRows(members []models.TeamMember) []memberRow {
+	busiest := 0
+	for _, m := range members {
+		if m.Workload != nil && m.Workload.OpenReviews > busiest {
+			busiest = m.Workload.OpenReviews
+		}
+	}
+
+	rows := make([]memberRow, 0, len(members))
+	for _, m := range members {
+		row := memberRow{TeamMember: m}
+		if m.Workload != nil && busiest > 0 {
+			row.LoadPercent = m.Workload.OpenReviews * 100 / busiest
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// render executes into a buffer first so a template error still results in a clean 500.
+func (h *Handler) render(w http.ResponseWriter, name string, data any) {
+	var buf bytes.Buffer
+	if err := h.templates.ExecuteTemplate(&buf, name, data); err != nil {
+		h.logger.Error("dashboard: render failed", "page", name, "err", err)
+		http.Error(w, "failed to render page", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = buf.WriteTo(w)
+}
+
+func (h *Handler) serverError(w http.ResponseWriter, teamName, what string, err error) {
+	h.logger.Error("dashboard: load failed", "team", teamName, "what", what, "err", err)
+	http.Error(w, "failed to load "+what, http.StatusInternalServerError)
+}
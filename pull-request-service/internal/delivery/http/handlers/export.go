@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pull-request-service/internal/delivery/http/helpers"
+	"pull-request-service/internal/models"
+)
+
+type ExportService interface {
+	ExportReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error
+	ExportPullRequests(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error) error
+	ExportRoster(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error) error
+}
+
+type ExportHandler struct {
+	exportService ExportService
+	logger        *slog.Logger
+	validator     Validator
+}
+
+func NewExportHandler(s ExportService, logger *slog.Logger, validator Validator) *ExportHandler {
+	return &ExportHandler{
+		exportService: s,
+		logger:        logger,
+		validator:     validator,
+	}
+}
+
+func (h *ExportHandler) ReviewsStats(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.ReviewsStatsQuery{
+		TeamName: params.Get("team_name"),
+		Status:   models.PullRequestStatus(params.Get("status")),
+	}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	stream := helpers.NewCSVStream(w, "reviews-stats.csv", []string{
+		"user_id", "username", "team_name", "is_active", "reviews_number", "open_reviews", "merged_reviews",
+	})
+	err = h.exportService.ExportReviewsStats(r.Context(), &query, func(rs *models.ReviewerStats) error {
+		return stream.Write([]string{
+			rs.UserID,
+			rs.Username,
+			rs.TeamName,
+			strconv.FormatBool(rs.IsActive),
+			strconv.Itoa(rs.ReviewsNumber),
+			strconv.Itoa(rs.OpenReviews),
+			strconv.Itoa(rs.MergedReviews),
+		})
+	})
+	h.finish(w, stream, "reviews stats", err)
+}
+
+func (h *ExportHandler) PullRequests(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.PullRequestExportQuery{
+		Status:   models.PullRequestStatus(params.Get("status")),
+		AuthorID: params.Get("author_id"),
+	}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	stream := helpers.NewCSVStream(w, "pull-requests.csv", []string{
+		"pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "assigned_reviewers",
+	})
+	err = h.exportService.ExportPullRequests(r.Context(), &query, func(pr *models.PullRequest) error {
+		return stream.Write([]string{
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			string(pr.Status),
+			formatCSVTime(pr.CreatedAt),
+			formatCSVTime(pr.MergedAt),
+			strings.Join(pr.Assigned, ";"),
+		})
+	})
+	h.finish(w, stream, "PRs", err)
+}
+
+func (h *ExportHandler) Teams(w http.ResponseWriter, r *http.Request) {
+	query := models.RosterExportQuery{TeamName: r.URL.Query().Get("team_name")}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	stream := helpers.NewCSVStream(w, "teams.csv", []string{
+		"team_name", "user_id", "username", "is_active", "is_primary", "role",
+	})
+	err := h.exportService.ExportRoster(r.Context(), &query, func(e *models.RosterEntry) error {
+		return stream.Write([]string{
+			e.TeamName,
+			e.UserID,
+			e.Username,
+			strconv.FormatBool(e.IsActive),
+			strconv.FormatBool(e.IsPrimary),
+			string(e.Role),
+		})
+	})
+	h.finish(w, stream, "team rosters", err)
+}
+
+// finish closes the stream. Once rows have been sent the status can no longer change,
+// so a late failure is only logged and the client sees a truncated file.
+func (h *ExportHandler) finish(w http.ResponseWriter, stream *helpers.CSVStream, what string, err error) {
+	if err == nil {
+		err = stream.Close()
+	}
+	if err == nil {
+		return
+	}
+
+	h.logger.Error("export failed", "export", what, "err", err)
+	if !stream.Started() {
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to export "+what)
+	}
+}
+
+func formatCSVTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
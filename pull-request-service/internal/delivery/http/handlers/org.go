@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"pull-request-service/internal/delivery/http/helpers"
+	"pull-request-service/internal/models"
+)
+
+const maxOrgChartSize = 10 << 20
+
+type OrgService interface {
+	Import(ctx context.Context, r io.Reader, format models.OrgChartFormat, dryRun bool) (*models.OrgDiff, error)
+}
+
+type OrgHandler struct {
+	orgService OrgService
+	logger     *slog.Logger
+}
+
+func NewOrgHandler(s OrgService, logger *slog.Logger) *OrgHandler {
+	return &OrgHandler{
+		orgService: s,
+		logger:     logger,
+	}
+}
+
+func (h *OrgHandler) Import(w http.ResponseWriter, r *http.Request) {
+	format := models.OrgChartFormat(r.URL.Query().Get("format"))
+	if format == "" && strings.Contains(r.Header.Get("Content-Type"), "csv") {
+		format = models.OrgChartCSV
+	}
+	if format == "" {
+		format = models.OrgChartYAML
+	}
+	if format != models.OrgChartYAML && format != models.OrgChartCSV {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidOrgChart, "format must be yaml or csv")
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	diff, err := h.orgService.Import(r.Context(), http.MaxBytesReader(w, r.Body, maxOrgChartSize), format, dryRun)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "INVALID_ORG_CHART"):
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidOrgChart, strings.TrimPrefix(errStr, "error: code: INVALID_ORG_CHART, message: "))
+		case strings.Contains(errStr, "NO_CANDIDATE"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrNoCandidate, "no active replacement candidate for open reviews")
+		default:
+			h.logger.Error("org import failed", "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "org import failed")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"diff": diff})
+}
@@ -13,8 +13,11 @@ import (
 
 type PullRequestService interface {
 	CreatePR(ctx context.Context, pr *models.PullRequest) (*models.PullRequest, error)
-	MergePR(ctx context.Context, prID string) (*models.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*models.PullRequest, string, error)
+	BatchCreatePR(ctx context.Context, prs []*models.PullRequest) ([]*models.BatchCreatePRResult, error)
+	MergePR(ctx context.Context, prID string, expectedVersion int) (*models.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, expectedVersion int) (*models.PullRequest, string, error)
+	StartReview(ctx context.Context, prID, userID string, expectedVersion int) (*models.ReviewAssignment, int, error)
+	DeletePR(ctx context.Context, prID string, expectedVersion int) (*models.PullRequest, error)
 }
 
 type PullRequestHandler struct {
@@ -62,9 +65,60 @@ func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	helpers.SetETag(w, result.Version)
 	helpers.WriteSuccess(w, http.StatusCreated, map[string]interface{}{"pr": result})
 }
 
+func (h *PullRequestHandler) BatchCreate(w http.ResponseWriter, r *http.Request) {
+	var req models.BatchCreatePRRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	results := make([]*models.BatchCreatePRResult, len(req.PullRequests))
+	prs := make([]*models.PullRequest, 0, len(req.PullRequests))
+	positions := make([]int, 0, len(req.PullRequests))
+
+	for i := range req.PullRequests {
+		item := &req.PullRequests[i]
+		if err := h.validator.Validate(item); err != nil {
+			results[i] = &models.BatchCreatePRResult{
+				PullRequestID: item.PullRequestID,
+				Error:         &models.ErrorObject{Code: models.ErrNotFound, Message: err.Error()},
+			}
+			continue
+		}
+
+		prs = append(prs, &models.PullRequest{
+			PullRequestID:   item.PullRequestID,
+			PullRequestName: item.PullRequestName,
+			AuthorID:        item.AuthorID,
+			Status:          models.StatusOpen,
+		})
+		positions = append(positions, i)
+	}
+
+	created, err := h.prService.BatchCreatePR(r.Context(), prs)
+	if err != nil {
+		h.logger.Error("batch create PR failed", "items", len(prs), "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "batch create failed")
+		return
+	}
+
+	for i, result := range created {
+		results[positions[i]] = result
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"results": results})
+}
+
 func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 	var req models.MergePRRequest
 
@@ -78,13 +132,24 @@ func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.prService.MergePR(r.Context(), req.PullRequestID)
+	expectedVersion, err := helpers.ParseIfMatch(r)
 	if err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	result, err := h.prService.MergePR(r.Context(), req.PullRequestID, expectedVersion)
+	if err != nil {
+		if strings.Contains(err.Error(), "VERSION_MISMATCH") {
+			helpers.WriteError(w, http.StatusPreconditionFailed, models.ErrVersionMismatch, "PR was modified concurrently")
+			return
+		}
 		h.logger.Error("merge PR failed", "pr_id", req.PullRequestID, "err", err)
 		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "PR not found")
 		return
 	}
 
+	helpers.SetETag(w, result.Version)
 	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"pr": result})
 }
 
@@ -101,9 +166,19 @@ func (h *PullRequestHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, newReviewerID, err := h.prService.ReassignReviewer(r.Context(), req.PullRequestID, req.OldReviewerID)
+	expectedVersion, err := helpers.ParseIfMatch(r)
+	if err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	result, newReviewerID, err := h.prService.ReassignReviewer(r.Context(), req.PullRequestID, req.OldReviewerID, expectedVersion)
 	if err != nil {
 		errStr := err.Error()
+		if strings.Contains(errStr, "VERSION_MISMATCH") {
+			helpers.WriteError(w, http.StatusPreconditionFailed, models.ErrVersionMismatch, "PR was modified concurrently")
+			return
+		}
 		if strings.Contains(errStr, "PR_MERGED") {
 			helpers.WriteError(w, http.StatusConflict, models.ErrPRMerged, "cannot reassign on merged PR")
 			return
@@ -121,8 +196,85 @@ func (h *PullRequestHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	helpers.SetETag(w, result.Version)
 	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{
 		"pr":          result,
 		"replaced_by": newReviewerID,
 	})
 }
+
+func (h *PullRequestHandler) StartReview(w http.ResponseWriter, r *http.Request) {
+	var req models.StartReviewRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	expectedVersion, err := helpers.ParseIfMatch(r)
+	if err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	review, version, err := h.prService.StartReview(r.Context(), req.PullRequestID, req.UserID, expectedVersion)
+	if err != nil {
+		errStr := err.Error()
+		if strings.Contains(errStr, "VERSION_MISMATCH") {
+			helpers.WriteError(w, http.StatusPreconditionFailed, models.ErrVersionMismatch, "PR was modified concurrently")
+			return
+		}
+		if strings.Contains(errStr, "PR_MERGED") {
+			helpers.WriteError(w, http.StatusConflict, models.ErrPRMerged, "cannot start review on merged PR")
+			return
+		}
+		if strings.Contains(errStr, "NOT_ASSIGNED") {
+			helpers.WriteError(w, http.StatusConflict, models.ErrNotAssigned, "reviewer is not assigned to this PR")
+			return
+		}
+		h.logger.Error("start review failed", "pr_id", req.PullRequestID, "user_id", req.UserID, "err", err)
+		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "PR not found")
+		return
+	}
+
+	helpers.SetETag(w, version)
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"review": review})
+}
+
+func (h *PullRequestHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var req models.DeletePRRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	expectedVersion, err := helpers.ParseIfMatch(r)
+	if err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	result, err := h.prService.DeletePR(r.Context(), req.PullRequestID, expectedVersion)
+	if err != nil {
+		if strings.Contains(err.Error(), "VERSION_MISMATCH") {
+			helpers.WriteError(w, http.StatusPreconditionFailed, models.ErrVersionMismatch, "PR was modified concurrently")
+			return
+		}
+		h.logger.Error("delete PR failed", "pr_id", req.PullRequestID, "err", err)
+		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "PR not found")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"pr": result})
+}
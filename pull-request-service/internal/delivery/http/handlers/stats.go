@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"pull-request-service/internal/delivery/http/helpers"
+	"pull-request-service/internal/models"
+)
+
+type StatsService interface {
+	GetCycleTime(ctx context.Context, q *models.CycleTimeQuery) (*models.CycleTimeReport, error)
+	GetFairness(ctx context.Context, q *models.FairnessQuery) (*models.FairnessReport, error)
+	CheckReviewerCounters(ctx context.Context, rebuild bool) (*models.CounterCheckReport, error)
+	GetLoadTrend(ctx context.Context, q *models.LoadTrendQuery) (*models.LoadTrend, error)
+}
+
+type StatsHandler struct {
+	statsService StatsService
+	logger       *slog.Logger
+	validator    Validator
+}
+
+func NewStatsHandler(s StatsService, logger *slog.Logger, validator Validator) *StatsHandler {
+	return &StatsHandler{
+		statsService: s,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+func (h *StatsHandler) GetCycleTime(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.CycleTimeQuery{GroupBy: models.CycleTimeGroupBy(params.Get("group_by"))}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	report, err := h.statsService.GetCycleTime(r.Context(), &query)
+	if err != nil {
+		h.logger.Error("get cycle time failed", "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get cycle time")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"cycle_time": report})
+}
+
+func (h *StatsHandler) GetFairness(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.FairnessQuery{TeamName: params.Get("team_name")}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	report, err := h.statsService.GetFairness(r.Context(), &query)
+	if err != nil {
+		h.logger.Error("get fairness failed", "team", query.TeamName, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get fairness report")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"fairness": report})
+}
+
+func (h *StatsHandler) CheckCounters(w http.ResponseWriter, r *http.Request) {
+	h.checkCounters(w, r, false)
+}
+
+func (h *StatsHandler) RebuildCounters(w http.ResponseWriter, r *http.Request) {
+	h.checkCounters(w, r, true)
+}
+
+func (h *StatsHandler) checkCounters(w http.ResponseWriter, r *http.Request, rebuild bool) {
+	report, err := h.statsService.CheckReviewerCounters(r.Context(), rebuild)
+	if err != nil {
+		h.logger.Error("check reviewer counters failed", "rebuild", rebuild, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to check reviewer counters")
+		return
+	}
+
+	if !report.Consistent {
+		h.logger.Warn("reviewer counters drifted", "users", len(report.Drift), "rebuilt", report.Rebuilt)
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"counters": report})
+}
+
+func (h *StatsHandler) GetTrend(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.LoadTrendQuery{
+		UserID:   params.Get("user_id"),
+		TeamName: params.Get("team_name"),
+	}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	trend, err := h.statsService.GetLoadTrend(r.Context(), &query)
+	if err != nil {
+		h.logger.Error("get load trend failed", "user", query.UserID, "team", query.TeamName, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get load trend")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"trend": trend})
+}
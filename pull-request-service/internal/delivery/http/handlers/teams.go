@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"pull-request-service/internal/delivery/http/helpers"
 	"pull-request-service/internal/models"
@@ -12,7 +13,13 @@ import (
 
 type TeamService interface {
 	AddTeam(ctx context.Context, team *models.Team) error
+	SyncTeam(ctx context.Context, team *models.Team) (*models.TeamSyncReport, error)
 	GetTeam(ctx context.Context, teamName string) (*models.Team, error)
+	UpdateTeam(ctx context.Context, req *models.UpdateTeamRequest) (*models.Team, error)
+	DeleteTeam(ctx context.Context, teamName, reassignTo string) error
+	GetTeamSubtree(ctx context.Context, teamName string) (*models.Team, error)
+	GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error)
+	AddWorkload(ctx context.Context, team *models.Team) error
 }
 
 type TeamHandler struct {
@@ -43,7 +50,7 @@ func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 
 	err := h.teamService.AddTeam(r.Context(), &req)
 	if err != nil {
-		helpers.WriteError(w, http.StatusBadRequest, models.ErrTeamExists, "team_name already exists")
+		h.writeTeamWriteError(w, req.TeamName, err)
 		return
 	}
 
@@ -60,17 +67,168 @@ func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
 	teamName := r.URL.Query().Get("team_name")
 
-	query := models.GetTeamQuery{TeamName: teamName}
+	query := models.GetTeamQuery{
+		TeamName:       teamName,
+		IncludeSubtree: r.URL.Query().Get("include_subtree") == "true",
+		Include:        r.URL.Query().Get("include"),
+	}
 	if err := h.validator.Validate(&query); err != nil {
 		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
 		return
 	}
 
-	resp, err := h.teamService.GetTeam(r.Context(), teamName)
+	var resp *models.Team
+	var err error
+	if query.IncludeSubtree {
+		resp, err = h.teamService.GetTeamSubtree(r.Context(), teamName)
+	} else {
+		resp, err = h.teamService.GetTeam(r.Context(), teamName)
+	}
 	if err != nil {
 		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "team not found")
 		return
 	}
 
+	if query.Include == "workload" {
+		if err := h.teamService.AddWorkload(r.Context(), resp); err != nil {
+			h.logger.Error("get team workload failed", "team", teamName, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get team workload")
+			return
+		}
+	}
+
 	helpers.WriteSuccess(w, http.StatusOK, resp)
 }
+
+func (h *TeamHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req models.UpdateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	team, err := h.teamService.UpdateTeam(r.Context(), &req)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "TEAM_EXISTS"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrTeamExists, "team_name already exists")
+		case strings.Contains(errStr, "INVALID_PARENT"):
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidParent, "parent team would create a cycle")
+		case strings.Contains(errStr, "NOT_FOUND"):
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "team not found")
+		default:
+			h.logger.Error("update team failed", "team", req.TeamName, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to update team")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"team": team})
+}
+
+func (h *TeamHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var req models.DeleteTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	err := h.teamService.DeleteTeam(r.Context(), req.TeamName, req.ReassignTo)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "INVALID_REASSIGN"):
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidReassign, "reassign_to must differ from team_name")
+		case strings.Contains(errStr, "TEAM_NOT_EMPTY"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrTeamNotEmpty, "team still has members, pass reassign_to")
+		case strings.Contains(errStr, "TEAM_HAS_SUBTEAMS"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrTeamHasSubteams, "team has sub-teams")
+		case strings.Contains(errStr, "NOT_FOUND"):
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "team not found")
+		default:
+			h.logger.Error("delete team failed", "team", req.TeamName, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to delete team")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{
+		"team_name":   req.TeamName,
+		"reassign_to": req.ReassignTo,
+	})
+}
+
+func (h *TeamHandler) GetReviewsStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.teamService.GetTeamReviewsStats(r.Context())
+	if err != nil {
+		h.logger.Error("get team reviews stats failed", "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get team reviews stats")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"teams": stats})
+}
+
+func (h *TeamHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	var req models.Team
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	report, err := h.teamService.SyncTeam(r.Context(), &req)
+	if err != nil {
+		h.writeTeamWriteError(w, req.TeamName, err)
+		return
+	}
+
+	team, err := h.teamService.GetTeam(r.Context(), req.TeamName)
+	if err != nil {
+		h.logger.Error("failed to get synced team", "team", req.TeamName, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get synced team")
+		return
+	}
+
+	status := http.StatusOK
+	if report.Created {
+		status = http.StatusCreated
+	}
+
+	helpers.WriteSuccess(w, status, map[string]interface{}{
+		"team":   team,
+		"report": report,
+	})
+}
+
+func (h *TeamHandler) writeTeamWriteError(w http.ResponseWriter, teamName string, err error) {
+	errStr := err.Error()
+	switch {
+	case strings.Contains(errStr, "TEAM_EXISTS"):
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrTeamExists, "team_name already exists")
+	case strings.Contains(errStr, "INVALID_PARENT"):
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidParent, "parent team would create a cycle")
+	case strings.Contains(errStr, "USER_OFFBOARDED"):
+		helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "offboarded users cannot be added to a team")
+	case strings.Contains(errStr, "NOT_FOUND"):
+		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "parent team not found")
+	default:
+		h.logger.Error("team write failed", "team", teamName, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to save team")
+	}
+}
@@ -5,15 +5,27 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"pull-request-service/internal/delivery/http/helpers"
 	"pull-request-service/internal/models"
 )
 
 type UsersService interface {
-	SetUserActiveStatus(ctx context.Context, userID string, isActive bool) (*models.User, error)
+	SetUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) (*models.User, error)
 	GetUserReviews(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
-	GetReviewsStats(ctx context.Context) ([]*models.ReviewerStats, error)
+	GetReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error)
+	GetPickupStats(ctx context.Context) (*models.PickupStats, error)
+	TransferUser(ctx context.Context, req *models.TransferUserRequest) (*models.User, []*models.ReviewHandover, error)
+	SetUserTeams(ctx context.Context, req *models.SetUserTeamsRequest) (*models.User, error)
+	GetUser(ctx context.Context, userID string) (*models.User, error)
+	ListUsers(ctx context.Context, q *models.ListUsersQuery) (*models.UsersPage, error)
+	SetUserRole(ctx context.Context, userID string, role models.UserRole) (*models.User, error)
+	OffboardUser(ctx context.Context, req *models.OffboardUserRequest) (*models.User, []*models.ReviewHandover, error)
+	UpdateProfile(ctx context.Context, req *models.UpdateProfileRequest) (*models.User, error)
+	CancelReactivation(ctx context.Context, userID string) (*models.User, error)
 }
 
 type UsersHandler struct {
@@ -42,8 +54,16 @@ func (h *UsersHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.usersService.SetUserActiveStatus(r.Context(), req.UserID, req.IsActive)
+	user, err := h.usersService.SetUserActiveStatus(r.Context(), req.UserID, req.IsActive, req.Until)
 	if err != nil {
+		if strings.Contains(err.Error(), "INVALID_UNTIL") {
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidUntil, strings.TrimPrefix(err.Error(), "error: code: INVALID_UNTIL, message: "))
+			return
+		}
+		if strings.Contains(err.Error(), "USER_OFFBOARDED") {
+			helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "offboarded user cannot be reactivated")
+			return
+		}
 		h.logger.Error("set user active status failed", "user_id", req.UserID, "err", err)
 		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user not found")
 		return
@@ -75,7 +95,24 @@ func (h *UsersHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UsersHandler) GetReviewsStats(w http.ResponseWriter, r *http.Request) {
-	stats, err := h.usersService.GetReviewsStats(r.Context())
+	params := r.URL.Query()
+
+	query := models.ReviewsStatsQuery{
+		TeamName: params.Get("team_name"),
+		Status:   models.PullRequestStatus(params.Get("status")),
+	}
+
+	var err error
+	if query.From, query.To, err = helpers.ParseTimeRange(params); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	stats, err := h.usersService.GetReviewsStats(r.Context(), &query)
 	if err != nil {
 		h.logger.Error("get reviews stats failed", "err", err)
 		helpers.WriteError(w, http.StatusNotFound, models.ErrNoCandidate, "no found users")
@@ -86,3 +123,260 @@ func (h *UsersHandler) GetReviewsStats(w http.ResponseWriter, r *http.Request) {
 		"reviews_stats_list": stats,
 	})
 }
+
+func (h *UsersHandler) GetPickupStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.usersService.GetPickupStats(r.Context())
+	if err != nil {
+		h.logger.Error("get pickup stats failed", "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get pickup stats")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{
+		"pickup_stats": stats,
+	})
+}
+
+func (h *UsersHandler) Transfer(w http.ResponseWriter, r *http.Request) {
+	var req models.TransferUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, handovers, err := h.usersService.TransferUser(r.Context(), &req)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "NO_CANDIDATE"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrNoCandidate, "no active replacement candidate for open reviews")
+		case strings.Contains(errStr, "USER_OFFBOARDED"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "user is offboarded")
+		case strings.Contains(errStr, "NOT_FOUND"):
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user or team not found")
+		default:
+			h.logger.Error("transfer user failed", "user_id", req.UserID, "team", req.TeamName, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to transfer user")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{
+		"user":        user,
+		"handed_over": handovers,
+	})
+}
+
+func (h *UsersHandler) SetTeams(w http.ResponseWriter, r *http.Request) {
+	var req models.SetUserTeamsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, err := h.usersService.SetUserTeams(r.Context(), &req)
+	if err != nil {
+		errStr := err.Error()
+		if strings.Contains(errStr, "INVALID_MEMBERSHIP") {
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrInvalidMembership, strings.TrimPrefix(errStr, "error: code: INVALID_MEMBERSHIP, message: "))
+			return
+		}
+		if strings.Contains(errStr, "USER_OFFBOARDED") {
+			helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "user is offboarded")
+			return
+		}
+		h.logger.Error("set user teams failed", "user_id", req.UserID, "err", err)
+		helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user or team not found")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"user": user})
+}
+
+func (h *UsersHandler) Get(w http.ResponseWriter, r *http.Request) {
+	query := models.GetUserQuery{UserID: r.URL.Query().Get("user_id")}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, err := h.usersService.GetUser(r.Context(), query.UserID)
+	if err != nil {
+		if strings.Contains(err.Error(), "NOT_FOUND") {
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user not found")
+			return
+		}
+		h.logger.Error("get user failed", "user_id", query.UserID, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to get user")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"user": user})
+}
+
+func (h *UsersHandler) List(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := models.ListUsersQuery{
+		TeamName: params.Get("team_name"),
+		Role:     models.UserRole(params.Get("role")),
+		Search:   strings.TrimSpace(params.Get("search")),
+		Cursor:   params.Get("cursor"),
+	}
+	if v := params.Get("is_active"); v != "" {
+		isActive, err := strconv.ParseBool(v)
+		if err != nil {
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "is_active must be true or false")
+			return
+		}
+		query.IsActive = &isActive
+	}
+	if v := params.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "limit must be a number")
+			return
+		}
+		query.Limit = limit
+	}
+	if err := h.validator.Validate(&query); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	page, err := h.usersService.ListUsers(r.Context(), &query)
+	if err != nil {
+		h.logger.Error("list users failed", "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to list users")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, page)
+}
+
+func (h *UsersHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	var req models.SetUserRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, err := h.usersService.SetUserRole(r.Context(), req.UserID, req.Role)
+	if err != nil {
+		if strings.Contains(err.Error(), "NOT_FOUND") {
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user not found")
+			return
+		}
+		h.logger.Error("set user role failed", "user_id", req.UserID, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to set user role")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"user": user})
+}
+
+func (h *UsersHandler) Offboard(w http.ResponseWriter, r *http.Request) {
+	var req models.OffboardUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, handovers, err := h.usersService.OffboardUser(r.Context(), &req)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "NO_CANDIDATE"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrNoCandidate, "no active replacement candidate for open reviews")
+		case strings.Contains(errStr, "USER_OFFBOARDED"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "user is already offboarded")
+		case strings.Contains(errStr, "NOT_FOUND"):
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user or handover user not found")
+		default:
+			h.logger.Error("offboard user failed", "user_id", req.UserID, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to offboard user")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{
+		"user":      user,
+		"handovers": handovers,
+	})
+}
+
+func (h *UsersHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var req models.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, err := h.usersService.UpdateProfile(r.Context(), &req)
+	if err != nil {
+		errStr := err.Error()
+		switch {
+		case strings.Contains(errStr, "USER_OFFBOARDED"):
+			helpers.WriteError(w, http.StatusConflict, models.ErrUserOffboarded, "user is offboarded")
+		case strings.Contains(errStr, "NOT_FOUND"):
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user not found")
+		default:
+			h.logger.Error("update user profile failed", "user_id", req.UserID, "err", err)
+			helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to update profile")
+		}
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"user": user})
+}
+
+func (h *UsersHandler) CancelReactivation(w http.ResponseWriter, r *http.Request) {
+	var req models.CancelReactivationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, "invalid JSON")
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		helpers.WriteError(w, http.StatusBadRequest, models.ErrNotFound, err.Error())
+		return
+	}
+
+	user, err := h.usersService.CancelReactivation(r.Context(), req.UserID)
+	if err != nil {
+		if strings.Contains(err.Error(), "NOT_FOUND") {
+			helpers.WriteError(w, http.StatusNotFound, models.ErrNotFound, "user not found")
+			return
+		}
+		h.logger.Error("cancel reactivation failed", "user_id", req.UserID, "err", err)
+		helpers.WriteError(w, http.StatusInternalServerError, models.ErrInternal, "failed to cancel reactivation")
+		return
+	}
+
+	helpers.WriteSuccess(w, http.StatusOK, map[string]interface{}{"user": user})
+}
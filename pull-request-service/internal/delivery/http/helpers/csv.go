@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const csvFlushEvery = 1000
+
+// CSVStream writes a CSV attachment row by row, flushing to the client periodically.
+// Nothing is sent until the first row (or Close), so an error before that can still be
+// answered with a regular JSON error.
+type CSVStream struct {
+	w        http.ResponseWriter
+	csv      *csv.Writer
+	filename string
+	header   []string
+	started  bool
+	rows     int
+}
+
+func NewCSVStream(w http.ResponseWriter, filename string, header []string) *CSVStream {
+	return &CSVStream{
+		w:        w,
+		csv:      csv.NewWriter(w),
+		filename: filename,
+		header:   header,
+	}
+}
+
+func (s *CSVStream) Started() bool {
+	return s.started
+}
+
+func (s *CSVStream) Write(record []string) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	for i, v := range record {
+		record[i] = csvSafe(v)
+	}
+	if err := s.csv.Write(record); err != nil {
+		return fmt.Errorf("writing CSV row: %w", err)
+	}
+
+	s.rows++
+	if s.rows%csvFlushEvery == 0 {
+		return s.flush()
+	}
+
+	return nil
+}
+
+// Close writes the header row if no rows were written and flushes what is buffered.
+func (s *CSVStream) Close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+func (s *CSVStream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+
+	s.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, s.filename))
+	s.w.WriteHeader(http.StatusOK)
+
+	if err := s.csv.Write(s.header); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CSVStream) flush() error {
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return fmt.Errorf("flushing CSV: %w", err)
+	}
+
+	_ = http.NewResponseController(s.w).Flush()
+
+	return nil
+}
+
+// csvSafe stops spreadsheets from evaluating user-provided values as formulas.
+func csvSafe(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+
+	return v
+}
@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ParseIfMatch returns the PR version from the If-Match header.
+// Zero means the header is absent or "*", i.e. the request is unconditional.
+func ParseIfMatch(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	value = strings.TrimPrefix(value, "W/")
+	value = strings.Trim(value, `"`)
+
+	version, err := strconv.Atoi(value)
+	if err != nil || version <= 0 {
+		return 0, errors.New("If-Match must contain a PR version")
+	}
+
+	return version, nil
+}
+
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ParseTimeParam reads an optional RFC 3339 timestamp or YYYY-MM-DD date (midnight UTC) query parameter.
+func ParseTimeParam(values url.Values, name string) (*time.Time, error) {
+	value := values.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			t = t.UTC()
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s must be an RFC 3339 timestamp or a YYYY-MM-DD date", name)
+}
+
+// ParseTimeRange reads the optional from/to parameters and checks that from is before to.
+func ParseTimeRange(values url.Values) (*time.Time, *time.Time, error) {
+	from, err := ParseTimeParam(values, "from")
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := ParseTimeParam(values, "to")
+	if err != nil {
+		return nil, nil, err
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, nil, errors.New("from must be before to")
+	}
+
+	return from, to, nil
+}
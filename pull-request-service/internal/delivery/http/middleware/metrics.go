@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type RequestObserver interface {
+	ObserveRequest(method, route string, status int, duration time.Duration)
+}
+
+// MetricsMiddleware records every request under its route template, so path parameters do not explode label cardinality.
+func MetricsMiddleware(observer RequestObserver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			wrapped := &responseWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(wrapped, r)
+
+			route := "unmatched"
+			if current := mux.CurrentRoute(r); current != nil {
+				if tpl, err := current.GetPathTemplate(); err == nil {
+					route = tpl
+				}
+			}
+
+			observer.ObserveRequest(r.Method, route, wrapped.statusCode, time.Since(start))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"pull-request-service/internal/delivery/http/handlers"
+
+	"github.com/gorilla/mux"
+)
+
+func SetupExportRoutes(api *mux.Router, h *handlers.ExportHandler) {
+	exportApi := api.PathPrefix("/export").Subrouter()
+
+	exportApi.HandleFunc("/reviewsStats.csv", h.ReviewsStats).Methods("GET")
+	exportApi.HandleFunc("/pullRequests.csv", h.PullRequests).Methods("GET")
+	exportApi.HandleFunc("/teams.csv", h.Teams).Methods("GET")
+}
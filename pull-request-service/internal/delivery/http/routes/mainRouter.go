@@ -3,14 +3,32 @@ package routes
 import (
 	"net/http"
 
+	"pull-request-service/internal/delivery/http/dashboard"
+
 	"github.com/gorilla/mux"
 )
 
-func SetupMainRouter(loggingMw func(http.Handler) http.Handler) *mux.Router {
+// SetupMainRouter returns the root router and the /api/v1 subrouter with the middlewares applied.
+func SetupMainRouter(loggingMw, metricsMw func(http.Handler) http.Handler) (*mux.Router, *mux.Router) {
 	r := mux.NewRouter()
 
 	api := r.PathPrefix("/api/v1").Subrouter()
-	api.Use(loggingMw)
+	api.Use(metricsMw, loggingMw)
+
+	return r, api
+}
+
+func SetupMetricsRoutes(r *mux.Router, h http.Handler) {
+	r.Handle("/metrics", h).Methods("GET")
+}
+
+// SetupDashboardRoutes mounts the HTML dashboard next to the API, with the given middlewares.
+func SetupDashboardRoutes(r *mux.Router, h *dashboard.Handler, mws ...mux.MiddlewareFunc) {
+	dashboardRouter := r.PathPrefix("/dashboard").Subrouter()
+	dashboardRouter.Use(mws...)
 
-	return api
+	dashboardRouter.HandleFunc("", h.Index).Methods("GET")
+	dashboardRouter.HandleFunc("/", h.Index).Methods("GET")
+	dashboardRouter.HandleFunc("/team", h.Team).Methods("GET")
+	dashboardRouter.PathPrefix("/static/").Handler(http.StripPrefix("/dashboard/static/", h.Static())).Methods("GET")
 }
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"pull-request-service/internal/delivery/http/handlers"
+
+	"github.com/gorilla/mux"
+)
+
+func SetupOrgRoutes(api *mux.Router, h *handlers.OrgHandler) {
+	orgApi := api.PathPrefix("/org").Subrouter()
+
+	orgApi.HandleFunc("/import", h.Import).Methods("POST")
+}
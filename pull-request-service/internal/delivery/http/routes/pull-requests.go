@@ -10,6 +10,9 @@ func SetupPullRequestRoutes(api *mux.Router, h *handlers.PullRequestHandler) {
 	pullRequestsApi := api.PathPrefix("/pullRequest").Subrouter()
 
 	pullRequestsApi.HandleFunc("/create", h.Create).Methods("POST")
+	pullRequestsApi.HandleFunc("/batchCreate", h.BatchCreate).Methods("POST")
 	pullRequestsApi.HandleFunc("/merge", h.Merge).Methods("POST")
 	pullRequestsApi.HandleFunc("/reassign", h.Reassign).Methods("POST")
+	pullRequestsApi.HandleFunc("/startReview", h.StartReview).Methods("POST")
+	pullRequestsApi.HandleFunc("/delete", h.Delete).Methods("POST")
 }
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"pull-request-service/internal/delivery/http/handlers"
+
+	"github.com/gorilla/mux"
+)
+
+func SetupStatsRoutes(api *mux.Router, h *handlers.StatsHandler) {
+	statsApi := api.PathPrefix("/stats").Subrouter()
+
+	statsApi.HandleFunc("/cycleTime", h.GetCycleTime).Methods("GET")
+	statsApi.HandleFunc("/fairness", h.GetFairness).Methods("GET")
+	statsApi.HandleFunc("/trend", h.GetTrend).Methods("GET")
+	statsApi.HandleFunc("/checkCounters", h.CheckCounters).Methods("GET")
+	statsApi.HandleFunc("/rebuildCounters", h.RebuildCounters).Methods("POST")
+}
@@ -10,5 +10,9 @@ func SetupTeamRoutes(api *mux.Router, h *handlers.TeamHandler) {
 	teamsApi := api.PathPrefix("/team").Subrouter()
 
 	teamsApi.HandleFunc("/add", h.Add).Methods("POST")
+	teamsApi.HandleFunc("/sync", h.Sync).Methods("POST")
 	teamsApi.HandleFunc("/get", h.Get).Methods("GET")
+	teamsApi.HandleFunc("/update", h.Update).Methods("POST")
+	teamsApi.HandleFunc("/delete", h.Delete).Methods("POST")
+	teamsApi.HandleFunc("/getReviewsStats", h.GetReviewsStats).Methods("GET")
 }
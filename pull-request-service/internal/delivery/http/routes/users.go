@@ -10,7 +10,16 @@ func SetupUsersRoutes(api *mux.Router, h *handlers.UsersHandler) {
 	usersApi := api.PathPrefix("/users").Subrouter()
 
 	usersApi.HandleFunc("/setIsActive", h.SetIsActive).Methods("POST")
+	usersApi.HandleFunc("/cancelReactivation", h.CancelReactivation).Methods("POST")
 	usersApi.HandleFunc("/getReview", h.GetReview).Methods("GET")
 	usersApi.HandleFunc("/getReviewsStats", h.GetReviewsStats).Methods("GET")
+	usersApi.HandleFunc("/getPickupStats", h.GetPickupStats).Methods("GET")
+	usersApi.HandleFunc("/transfer", h.Transfer).Methods("POST")
+	usersApi.HandleFunc("/setTeams", h.SetTeams).Methods("POST")
+	usersApi.HandleFunc("/setRole", h.SetRole).Methods("POST")
+	usersApi.HandleFunc("/offboard", h.Offboard).Methods("POST")
+	usersApi.HandleFunc("/updateProfile", h.UpdateProfile).Methods("POST")
+	usersApi.HandleFunc("/get", h.Get).Methods("GET")
+	usersApi.HandleFunc("/list", h.List).Methods("GET")
 
 }
@@ -44,6 +44,17 @@ func formatError(err validator.FieldError) string {
 		return fmt.Sprintf("%s is required", field)
 	case "status_enum":
 		return fmt.Sprintf("%s must be one of: OPEN, MERGED", field)
+	case "min":
+		if err.Kind().String() == "string" {
+			return fmt.Sprintf("%s must be at least %s characters", field, err.Param())
+		}
+		return fmt.Sprintf("%s must have at least %s items", field, err.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, err.Param())
+	case "required_if":
+		return fmt.Sprintf("%s is required when %s", field, err.Param())
+	case "nefield":
+		return fmt.Sprintf("%s must differ from %s", field, err.Param())
 	case "max":
 		if err.Kind().String() == "string" {
 			return fmt.Sprintf("%s must be at most %s characters", field, err.Param())
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const domainScrapeTimeout = 5 * time.Second
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	totalConns      *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquireCount    *prometheus.Desc
+	emptyAcquire    *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+	acquireDuration *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "pgxpool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool:            pool,
+		acquiredConns:   desc("acquired_conns", "Connections currently acquired from the pool."),
+		idleConns:       desc("idle_conns", "Idle connections in the pool."),
+		totalConns:      desc("total_conns", "Total connections in the pool."),
+		maxConns:        desc("max_conns", "Maximum size of the pool."),
+		acquireCount:    desc("acquire_total", "Successful connection acquisitions."),
+		emptyAcquire:    desc("empty_acquire_total", "Acquisitions that had to wait for a connection."),
+		canceledAcquire: desc("canceled_acquire_total", "Acquisitions canceled by their context."),
+		acquireDuration: desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.emptyAcquire
+	ch <- c.canceledAcquire
+	ch <- c.acquireDuration
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+}
+
+// DomainSource provides the current state gauges; it is queried on every scrape.
+type DomainSource interface {
+	CountOpenPRs(ctx context.Context) (int, error)
+	CountOpenReviewsByTeam(ctx context.Context) (map[string]int, error)
+}
+
+type domainCollector struct {
+	source DomainSource
+
+	openPRs     *prometheus.Desc
+	openReviews *prometheus.Desc
+}
+
+func newDomainCollector(source DomainSource) *domainCollector {
+	return &domainCollector{
+		source: source,
+		openPRs: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_pull_requests"),
+			"Open pull requests.", nil, nil,
+		),
+		openReviews: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_reviews"),
+			"Reviews on open pull requests by the reviewer's current team.", []string{"team"}, nil,
+		),
+	}
+}
+
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openPRs
+	ch <- c.openReviews
+}
+
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), domainScrapeTimeout)
+	defer cancel()
+
+	openPRs, err := c.source.CountOpenPRs(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.openPRs, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.openPRs, prometheus.GaugeValue, float64(openPRs))
+	}
+
+	byTeam, err := c.source.CountOpenReviewsByTeam(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.openReviews, err)
+		return
+	}
+	for team, n := range byTeam {
+		ch <- prometheus.MustNewConstMetric(c.openReviews, prometheus.GaugeValue, float64(n), team)
+	}
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pr_service"
+
+// Metrics owns the service registry: HTTP request metrics, pgxpool stats, Go runtime metrics
+// and domain metrics about pull requests and reviewer assignment.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	noCandidate   prometheus.Counter
+	reassignments prometheus.Counter
+}
+
+func New(pool *pgxpool.Pool, domain DomainSource) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		noCandidate: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_no_candidate_total",
+			Help:      "Reviewer reassignments that failed because no candidate was available.",
+		}),
+		reassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Reviewers replaced on a pull request.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.duration,
+		m.noCandidate,
+		m.reassignments,
+		newPoolCollector(pool),
+		newDomainCollector(domain),
+	)
+
+	return m
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	labels := prometheus.Labels{"method": method, "route": route, "status": strconv.Itoa(status)}
+	m.requests.With(labels).Inc()
+	m.duration.With(labels).Observe(duration.Seconds())
+}
+
+func (m *Metrics) NoCandidate() {
+	m.noCandidate.Inc()
+}
+
+func (m *Metrics) Reassigned() {
+	m.reassignments.Inc()
+}
@@ -9,6 +9,17 @@ const (
 	ErrNotAssigned ErrorCode = "NOT_ASSIGNED"
 	ErrNoCandidate ErrorCode = "NO_CANDIDATE"
 	ErrNotFound    ErrorCode = "NOT_FOUND"
+	ErrInternal    ErrorCode = "INTERNAL"
+
+	ErrVersionMismatch   ErrorCode = "VERSION_MISMATCH"
+	ErrTeamNotEmpty      ErrorCode = "TEAM_NOT_EMPTY"
+	ErrTeamHasSubteams   ErrorCode = "TEAM_HAS_SUBTEAMS"
+	ErrInvalidReassign   ErrorCode = "INVALID_REASSIGN"
+	ErrInvalidParent     ErrorCode = "INVALID_PARENT"
+	ErrInvalidOrgChart   ErrorCode = "INVALID_ORG_CHART"
+	ErrInvalidMembership ErrorCode = "INVALID_MEMBERSHIP"
+	ErrUserOffboarded    ErrorCode = "USER_OFFBOARDED"
+	ErrInvalidUntil      ErrorCode = "INVALID_UNTIL"
 )
 
 type ErrorResponse struct {
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// PullRequestExportQuery selects non-deleted PRs created in [From, To).
+type PullRequestExportQuery struct {
+	From     *time.Time        `validate:"-"`
+	To       *time.Time        `validate:"-"`
+	Status   PullRequestStatus `validate:"omitempty,oneof=OPEN MERGED"`
+	AuthorID string            `validate:"omitempty,max=255"`
+}
+
+// RosterExportQuery selects the memberships of one team, or of every team when TeamName is empty.
+type RosterExportQuery struct {
+	TeamName string `validate:"omitempty,max=255"`
+}
+
+type RosterEntry struct {
+	TeamName  string
+	UserID    string
+	Username  string
+	IsActive  bool
+	IsPrimary bool
+	Role      UserRole
+}
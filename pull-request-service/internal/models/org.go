@@ -0,0 +1,34 @@
+package models
+
+type OrgChartFormat string
+
+const (
+	OrgChartYAML OrgChartFormat = "yaml"
+	OrgChartCSV  OrgChartFormat = "csv"
+)
+
+type OrgUserChange struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	FromTeam string `json:"from_team,omitempty"`
+	ToTeam   string `json:"to_team,omitempty"`
+}
+
+// OrgOpenReview is an open review held by a moved, deactivated or removed user. ReplacedBy is
+// filled in once the import is applied and the review is reassigned.
+type OrgOpenReview struct {
+	UserID        string `json:"user_id"`
+	PullRequestID string `json:"pull_request_id"`
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+}
+
+type OrgDiff struct {
+	AddedTeams  []string        `json:"added_teams"`
+	Added       []OrgUserChange `json:"added"`
+	Moved       []OrgUserChange `json:"moved"`
+	Deactivated []OrgUserChange `json:"deactivated"`
+	Reactivated []OrgUserChange `json:"reactivated"`
+	Removed     []OrgUserChange `json:"removed"`
+	OpenReviews []OrgOpenReview `json:"open_reviews"`
+	Applied     bool            `json:"applied"`
+}
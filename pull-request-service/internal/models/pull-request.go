@@ -9,6 +9,13 @@ const (
 	StatusMerged PullRequestStatus = "MERGED"
 )
 
+type DeleteMode string
+
+const (
+	DeleteModeSoft DeleteMode = "soft"
+	DeleteModeHard DeleteMode = "hard"
+)
+
 type PullRequest struct {
 	PullRequestID   string            `json:"pull_request_id" validate:"required,max=255"`
 	PullRequestName string            `json:"pull_request_name" validate:"required,max=255"`
@@ -17,6 +24,7 @@ type PullRequest struct {
 	Assigned        []string          `json:"assigned_reviewers" validate:"required,max=2"`
 	CreatedAt       *time.Time        `json:"createdAt,omitempty"`
 	MergedAt        *time.Time        `json:"mergedAt,omitempty"`
+	Version         int               `json:"version"`
 }
 
 type PullRequestShort struct {
@@ -32,11 +40,37 @@ type CreatePRRequest struct {
 	AuthorID        string `json:"author_id" validate:"required,max=255"`
 }
 
+type BatchCreatePRRequest struct {
+	PullRequests []CreatePRRequest `json:"pull_requests" validate:"required,min=1,max=1000"`
+}
+
+type BatchCreatePRResult struct {
+	PullRequestID string       `json:"pull_request_id"`
+	PR            *PullRequest `json:"pr,omitempty"`
+	Error         *ErrorObject `json:"error,omitempty"`
+}
+
 type MergePRRequest struct {
 	PullRequestID string `json:"pull_request_id" validate:"required,max=255"`
 }
 
+type DeletePRRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,max=255"`
+}
+
 type ReassignReviewerRequest struct {
 	PullRequestID string `json:"pull_request_id" validate:"required,max=255"`
 	OldReviewerID string `json:"old_reviewer_id" validate:"required,max=255"`
 }
+
+type StartReviewRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,max=255"`
+	UserID        string `json:"user_id" validate:"required,max=255"`
+}
+
+type ReviewAssignment struct {
+	PullRequestID string     `json:"pull_request_id"`
+	UserID        string     `json:"user_id"`
+	AssignedAt    time.Time  `json:"assignedAt"`
+	StartedAt     *time.Time `json:"startedAt,omitempty"`
+}
@@ -0,0 +1,154 @@
+package models
+
+import "time"
+
+type CycleTimeGroupBy string
+
+const (
+	CycleTimeByTeam   CycleTimeGroupBy = "team"
+	CycleTimeByAuthor CycleTimeGroupBy = "author"
+	CycleTimeByWeek   CycleTimeGroupBy = "week"
+)
+
+// CycleTimeQuery selects PRs created in [From, To). Team grouping uses the author's team at PR creation,
+// week grouping uses the ISO week (Monday) of PR creation.
+type CycleTimeQuery struct {
+	GroupBy CycleTimeGroupBy `validate:"omitempty,oneof=team author week"`
+	From    *time.Time       `validate:"-"`
+	To      *time.Time       `validate:"-"`
+}
+
+// Percentiles are in seconds; they are absent when the group has no matching PRs.
+type Percentiles struct {
+	P50 *float64 `json:"p50,omitempty"`
+	P90 *float64 `json:"p90,omitempty"`
+	P99 *float64 `json:"p99,omitempty"`
+}
+
+type CycleTimeStats struct {
+	Key               string      `json:"key"`
+	PRsCreated        int         `json:"prs_created"`
+	PRsMerged         int         `json:"prs_merged"`
+	TimeToMerge       Percentiles `json:"time_to_merge"`
+	PRsReviewed       int         `json:"prs_reviewed"`
+	TimeToFirstReview Percentiles `json:"time_to_first_review"`
+}
+
+type CycleTimeReport struct {
+	GroupBy CycleTimeGroupBy  `json:"group_by"`
+	From    *time.Time        `json:"from,omitempty"`
+	To      *time.Time        `json:"to,omitempty"`
+	Groups  []*CycleTimeStats `json:"groups"`
+}
+
+// FairnessQuery covers assignments made in [From, To). Members are active users who belonged to
+// the team at some point in the window according to team membership history.
+type FairnessQuery struct {
+	TeamName string     `validate:"omitempty,max=255"`
+	From     *time.Time `validate:"-"`
+	To       *time.Time `validate:"-"`
+}
+
+type MemberAssignments struct {
+	TeamName    string `json:"-"`
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	Assignments int    `json:"assignments"`
+}
+
+// FairnessOutlier is a member whose assignment count is more than 1.5 standard deviations from the team mean.
+type FairnessOutlier struct {
+	UserID      string  `json:"user_id"`
+	Username    string  `json:"username"`
+	Assignments int     `json:"assignments"`
+	Deviation   float64 `json:"deviation"`
+}
+
+// TeamFairness describes how assignments are spread across a team. MaxMinRatio is absent
+// when some member got no assignments.
+type TeamFairness struct {
+	TeamName    string               `json:"team_name"`
+	Assignments int                  `json:"assignments"`
+	Mean        float64              `json:"mean"`
+	StdDev      float64              `json:"std_dev"`
+	Gini        float64              `json:"gini"`
+	MaxMinRatio *float64             `json:"max_min_ratio"`
+	Outliers    []*FairnessOutlier   `json:"outliers"`
+	Members     []*MemberAssignments `json:"members"`
+}
+
+type FairnessReport struct {
+	From  *time.Time      `json:"from,omitempty"`
+	To    *time.Time      `json:"to,omitempty"`
+	Teams []*TeamFairness `json:"teams"`
+}
+
+type ReviewCounters struct {
+	Reviews       int `json:"reviews"`
+	OpenReviews   int `json:"open_reviews"`
+	MergedReviews int `json:"merged_reviews"`
+}
+
+// CounterDrift is a reviewer whose stored counters differ from the ones recomputed from pr_reviewers.
+type CounterDrift struct {
+	UserID string         `json:"user_id"`
+	Stored ReviewCounters `json:"stored"`
+	Actual ReviewCounters `json:"actual"`
+}
+
+type CounterCheckReport struct {
+	Consistent bool            `json:"consistent"`
+	Rebuilt    bool            `json:"rebuilt"`
+	Drift      []*CounterDrift `json:"drift"`
+}
+
+type OpenPullRequest struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	Reviewers       []string   `json:"reviewers"`
+}
+
+// OverdueReview is an assignment on an open PR that has not been picked up in time.
+type OverdueReview struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	UserID          string    `json:"user_id"`
+	Username        string    `json:"username"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	WaitingSeconds  float64   `json:"waiting_seconds"`
+}
+
+// DailyActivity counts, for one UTC day, reviews assigned to team members and reviews by team members on PRs merged that day.
+type DailyActivity struct {
+	Day      time.Time `json:"day"`
+	Assigned int       `json:"assigned"`
+	Merged   int       `json:"merged"`
+}
+
+// LoadTrendQuery selects daily snapshots in [From, To) for exactly one user or one team.
+// A team series covers whoever had the team as primary team on each day.
+type LoadTrendQuery struct {
+	UserID   string     `validate:"required_without=TeamName,excluded_with=TeamName,max=255"`
+	TeamName string     `validate:"required_without=UserID,max=255"`
+	From     *time.Time `validate:"-"`
+	To       *time.Time `validate:"-"`
+}
+
+// LoadTrendPoint is one day of the series. CompletedReviews counts reviews on PRs merged that day.
+type LoadTrendPoint struct {
+	Day              time.Time `json:"day"`
+	Members          int       `json:"members"`
+	OpenReviews      int       `json:"open_reviews"`
+	MaxOpenReviews   int       `json:"max_open_reviews"`
+	CompletedReviews int       `json:"completed_reviews"`
+}
+
+type LoadTrend struct {
+	UserID   string            `json:"user_id,omitempty"`
+	TeamName string            `json:"team_name,omitempty"`
+	From     *time.Time        `json:"from,omitempty"`
+	To       *time.Time        `json:"to,omitempty"`
+	Points   []*LoadTrendPoint `json:"points"`
+}
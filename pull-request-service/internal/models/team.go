@@ -1,16 +1,77 @@
 package models
 
+import "time"
+
 type TeamMember struct {
 	UserID   string `json:"user_id" validate:"required,max=255"`
 	Username string `json:"username" validate:"required,max=255"`
 	IsActive bool   `json:"is_active"`
+
+	// Filled in responses only; /team/add always makes the team the member's primary one.
+	IsPrimary    *bool           `json:"is_primary,omitempty" validate:"-"`
+	ReviewWeight *float64        `json:"review_weight,omitempty" validate:"-"`
+	Workload     *MemberWorkload `json:"workload,omitempty" validate:"-"`
+}
+
+type MemberWorkload struct {
+	OpenReviews                int        `json:"open_reviews"`
+	OldestOpenReviewAgeSeconds *float64   `json:"oldest_open_review_age_seconds,omitempty"`
+	ReviewsLast30Days          int        `json:"reviews_last_30_days"`
+	IsAbsent                   bool       `json:"is_absent"`
+	AbsentUntil                *time.Time `json:"absent_until,omitempty"`
 }
 
 type Team struct {
-	TeamName string       `json:"team_name" validate:"required,max=255"`
-	Members  []TeamMember `json:"members" validate:"required,dive"`
+	TeamName       string       `json:"team_name" validate:"required,max=255"`
+	ParentTeamName string       `json:"parent_team_name,omitempty" validate:"omitempty,max=255,nefield=TeamName"`
+	Members        []TeamMember `json:"members" validate:"required,dive"`
+	SubTeams       []*Team      `json:"sub_teams,omitempty" validate:"-"`
 }
 
 type GetTeamQuery struct {
-	TeamName string `validate:"required,max=255"`
+	TeamName       string `validate:"required,max=255"`
+	IncludeSubtree bool
+	Include        string `validate:"omitempty,oneof=workload"`
+}
+
+type UpdateTeamRequest struct {
+	TeamName       string  `json:"team_name" validate:"required,max=255"`
+	NewTeamName    string  `json:"new_team_name" validate:"omitempty,max=255"`
+	ParentTeamName *string `json:"parent_team_name" validate:"omitempty,max=255"`
+}
+
+type DeleteTeamRequest struct {
+	TeamName   string `json:"team_name" validate:"required,max=255"`
+	ReassignTo string `json:"reassign_to" validate:"omitempty,max=255,nefield=TeamName"`
+}
+
+type TeamReviewStats struct {
+	TeamName               string `json:"team_name"`
+	ParentTeamName         string `json:"parent_team_name,omitempty"`
+	ReviewsNumber          int    `json:"reviews_number"`
+	TotalReviewsNumber     int    `json:"total_reviews_number"`
+	OpenReviewsNumber      int    `json:"open_reviews_number"`
+	TotalOpenReviewsNumber int    `json:"total_open_reviews_number"`
+}
+
+type TeamSyncStatus string
+
+const (
+	TeamSyncAdded     TeamSyncStatus = "added"
+	TeamSyncJoined    TeamSyncStatus = "joined"
+	TeamSyncUpdated   TeamSyncStatus = "updated"
+	TeamSyncUnchanged TeamSyncStatus = "unchanged"
+	TeamSyncRemoved   TeamSyncStatus = "removed"
+)
+
+type TeamSyncMemberChange struct {
+	UserID   string         `json:"user_id"`
+	Status   TeamSyncStatus `json:"status"`
+	FromTeam string         `json:"from_team,omitempty"`
+}
+
+type TeamSyncReport struct {
+	TeamName string                 `json:"team_name"`
+	Created  bool                   `json:"created"`
+	Members  []TeamSyncMemberChange `json:"members"`
 }
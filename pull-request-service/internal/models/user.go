@@ -1,22 +1,188 @@
 package models
 
+import "time"
+
+// User.TeamName is the primary team and keeps the v1 single-team shape; Teams lists every membership.
 type User struct {
-	UserID   string `json:"user_id" validate:"required,max=255"`
-	Username string `json:"username" validate:"required,max=255"`
-	TeamName string `json:"team_name" validate:"required,max=255"`
-	IsActive bool   `json:"is_active"`
+	UserID   string           `json:"user_id" validate:"required,max=255"`
+	Username string           `json:"username" validate:"required,max=255"`
+	TeamName string           `json:"team_name" validate:"required,max=255"`
+	IsActive bool             `json:"is_active"`
+	Role     UserRole         `json:"role,omitempty" validate:"-"`
+	Teams    []TeamMembership `json:"teams,omitempty" validate:"-"`
+
+	OffboardedAt *time.Time   `json:"offboarded_at,omitempty" validate:"-"`
+	Profile      *UserProfile `json:"profile,omitempty" validate:"-"`
+
+	// InactiveUntil is when a temporarily deactivated user becomes active again.
+	InactiveUntil *time.Time `json:"inactive_until,omitempty" validate:"-"`
+}
+
+type UserProfile struct {
+	Email             string                  `json:"email,omitempty"`
+	ChatHandle        string                  `json:"chat_handle,omitempty"`
+	PreferredLanguage string                  `json:"preferred_language,omitempty"`
+	Notifications     NotificationPreferences `json:"notifications"`
+}
+
+// NotificationPreferences tells whether the user wants to be notified about each review event.
+type NotificationPreferences struct {
+	Assigned   bool `json:"assigned"`
+	Reassigned bool `json:"reassigned"`
+	Overdue    bool `json:"overdue"`
+	Merged     bool `json:"merged"`
+}
+
+// UpdateProfileRequest changes only the fields that are present; an empty string clears a contact field.
+type UpdateProfileRequest struct {
+	UserID            string                         `json:"user_id" validate:"required,max=255"`
+	Email             *string                        `json:"email" validate:"omitempty,email,max=255"`
+	ChatHandle        *string                        `json:"chat_handle" validate:"omitempty,max=255"`
+	PreferredLanguage *string                        `json:"preferred_language" validate:"omitempty,bcp47_language_tag"`
+	Notifications     *UpdateNotificationPreferences `json:"notifications"`
+}
+
+type UpdateNotificationPreferences struct {
+	Assigned   *bool `json:"assigned"`
+	Reassigned *bool `json:"reassigned"`
+	Overdue    *bool `json:"overdue"`
+	Merged     *bool `json:"merged"`
+}
+
+type UserRole string
+
+const (
+	UserRoleMember UserRole = "member"
+	UserRoleLead   UserRole = "lead"
+)
+
+type GetUserQuery struct {
+	UserID string `validate:"required,max=255"`
+}
+
+// ListUsersQuery filters by any team membership; Search matches a username prefix or a similar username.
+// Cursor is the last user_id of the previous page.
+type ListUsersQuery struct {
+	TeamName string   `validate:"omitempty,max=255"`
+	IsActive *bool    `validate:"-"`
+	Role     UserRole `validate:"omitempty,oneof=member lead"`
+	Search   string   `validate:"omitempty,max=255"`
+	Limit    int      `validate:"gte=0,lte=500"`
+	Cursor   string   `validate:"omitempty,max=255"`
+}
+
+type UsersPage struct {
+	Users      []*User `json:"users"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+type SetUserRoleRequest struct {
+	UserID string   `json:"user_id" validate:"required,max=255"`
+	Role   UserRole `json:"role" validate:"required,oneof=member lead"`
+}
+
+type TeamMembership struct {
+	TeamName     string  `json:"team_name"`
+	IsPrimary    bool    `json:"is_primary"`
+	ReviewWeight float64 `json:"review_weight"`
 }
 
+type SetUserTeamsRequest struct {
+	UserID string                    `json:"user_id" validate:"required,max=255"`
+	Teams  []SetUserTeamsRequestItem `json:"teams" validate:"required,min=1,dive"`
+}
+
+// SetUserTeamsRequestItem.ReviewWeight defaults to 1; a zero weight keeps the user out of reviewer selection for that team.
+type SetUserTeamsRequestItem struct {
+	TeamName     string   `json:"team_name" validate:"required,max=255"`
+	IsPrimary    bool     `json:"is_primary"`
+	ReviewWeight *float64 `json:"review_weight" validate:"omitempty,gte=0,lte=100"`
+}
+
+// ReviewCandidate is an active user who can be assigned as a reviewer, weighted by their team membership.
+type ReviewCandidate struct {
+	UserID string
+	Weight float64
+}
+
+// SetIsActiveRequest.Until may only be set when deactivating; the user is reactivated once it passes.
 type SetIsActiveRequest struct {
-	UserID   string `json:"user_id" validate:"required,max=255"`
-	IsActive bool   `json:"is_active"`
+	UserID   string     `json:"user_id" validate:"required,max=255"`
+	IsActive bool       `json:"is_active"`
+	Until    *time.Time `json:"until"`
+}
+
+type CancelReactivationRequest struct {
+	UserID string `json:"user_id" validate:"required,max=255"`
+}
+
+type OpenReviewsPolicy string
+
+const (
+	OpenReviewsKeep     OpenReviewsPolicy = "keep"
+	OpenReviewsReassign OpenReviewsPolicy = "reassign"
+	OpenReviewsHandover OpenReviewsPolicy = "handover"
+)
+
+type TransferUserRequest struct {
+	UserID      string            `json:"user_id" validate:"required,max=255"`
+	TeamName    string            `json:"team_name" validate:"required,max=255"`
+	OpenReviews OpenReviewsPolicy `json:"open_reviews" validate:"omitempty,oneof=keep reassign handover"`
+	HandoverTo  string            `json:"handover_to" validate:"required_if=OpenReviews handover,max=255"`
+}
+
+// OffboardUserRequest.OpenReviews defaults to reassign; keeping reviews of a departed user is not allowed.
+type OffboardUserRequest struct {
+	UserID      string            `json:"user_id" validate:"required,max=255"`
+	OpenReviews OpenReviewsPolicy `json:"open_reviews" validate:"omitempty,oneof=reassign handover"`
+	HandoverTo  string            `json:"handover_to" validate:"required_if=OpenReviews handover,max=255"`
+}
+
+type ReviewHandover struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReplacedBy    string `json:"replaced_by"`
 }
 
 type GetUserReviewsQuery struct {
 	UserID string `validate:"required,max=255"`
 }
 
+// ReviewsStatsQuery narrows reviewer stats to assignments made in [From, To), to reviewers who were
+// in TeamName at assignment time and to PRs in the given status.
+type ReviewsStatsQuery struct {
+	From     *time.Time        `validate:"-"`
+	To       *time.Time        `validate:"-"`
+	TeamName string            `validate:"omitempty,max=255"`
+	Status   PullRequestStatus `validate:"omitempty,oneof=OPEN MERGED"`
+}
+
 type ReviewerStats struct {
 	UserID        string `json:"user_id"`
+	Username      string `json:"username"`
+	TeamName      string `json:"team_name"`
+	IsActive      bool   `json:"is_active"`
 	ReviewsNumber int    `json:"reviews_number"`
+	OpenReviews   int    `json:"open_reviews"`
+	MergedReviews int    `json:"merged_reviews"`
+}
+
+type UserPickupStats struct {
+	UserID                 string  `json:"user_id"`
+	PickedUp               int     `json:"picked_up"`
+	Pending                int     `json:"pending"`
+	AvgTimeToPickupSeconds float64 `json:"avg_time_to_pickup_seconds"`
+	AvgIdleSeconds         float64 `json:"avg_idle_seconds"`
+}
+
+type TeamPickupStats struct {
+	TeamName               string  `json:"team_name"`
+	PickedUp               int     `json:"picked_up"`
+	Pending                int     `json:"pending"`
+	AvgTimeToPickupSeconds float64 `json:"avg_time_to_pickup_seconds"`
+	AvgIdleSeconds         float64 `json:"avg_idle_seconds"`
+}
+
+type PickupStats struct {
+	Users []*UserPickupStats `json:"users"`
+	Teams []*TeamPickupStats `json:"teams"`
 }
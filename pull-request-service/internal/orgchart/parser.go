@@ -0,0 +1,193 @@
+package orgchart
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"pull-request-service/internal/models"
+)
+
+type yamlChart struct {
+	Teams []yamlTeam `yaml:"teams"`
+}
+
+type yamlTeam struct {
+	TeamName       string       `yaml:"team_name"`
+	ParentTeamName string       `yaml:"parent_team_name"`
+	Members        []yamlMember `yaml:"members"`
+}
+
+type yamlMember struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+// Parse reads an org chart document. Members are active unless is_active is explicitly false.
+//
+// YAML documents have a top-level "teams" list. CSV documents have a header row with the columns
+// team_name, user_id, username and optionally parent_team_name and is_active; a row with an empty
+// user_id declares a team without members.
+func Parse(r io.Reader, format models.OrgChartFormat) ([]*models.Team, error) {
+	var teams []*models.Team
+	var err error
+
+	switch format {
+	case models.OrgChartYAML, "":
+		teams, err = parseYAML(r)
+	case models.OrgChartCSV:
+		teams, err = parseCSV(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := check(teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func parseYAML(r io.Reader) ([]*models.Team, error) {
+	var chart yamlChart
+	if err := yaml.NewDecoder(r).Decode(&chart); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding yaml: %w", err)
+	}
+
+	teams := make([]*models.Team, 0, len(chart.Teams))
+	for _, yt := range chart.Teams {
+		team := &models.Team{
+			TeamName:       strings.TrimSpace(yt.TeamName),
+			ParentTeamName: strings.TrimSpace(yt.ParentTeamName),
+			Members:        make([]models.TeamMember, 0, len(yt.Members)),
+		}
+		for _, ym := range yt.Members {
+			team.Members = append(team.Members, models.TeamMember{
+				UserID:   strings.TrimSpace(ym.UserID),
+				Username: strings.TrimSpace(ym.Username),
+				IsActive: ym.IsActive == nil || *ym.IsActive,
+			})
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+func parseCSV(r io.Reader) ([]*models.Team, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"team_name", "user_id", "username"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv header is missing column %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var teams []*models.Team
+	byName := make(map[string]*models.Team)
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv line %d: %w", line, err)
+		}
+
+		teamName := field(record, "team_name")
+		parent := field(record, "parent_team_name")
+
+		team, ok := byName[teamName]
+		if !ok {
+			team = &models.Team{TeamName: teamName, ParentTeamName: parent, Members: []models.TeamMember{}}
+			byName[teamName] = team
+			teams = append(teams, team)
+		} else if parent != "" && team.ParentTeamName != "" && parent != team.ParentTeamName {
+			return nil, fmt.Errorf("line %d: team %q has conflicting parents", line, teamName)
+		} else if team.ParentTeamName == "" {
+			team.ParentTeamName = parent
+		}
+
+		userID := field(record, "user_id")
+		if userID == "" {
+			continue
+		}
+
+		isActive := true
+		if v := field(record, "is_active"); v != "" {
+			isActive, err = strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid is_active %q", line, v)
+			}
+		}
+
+		team.Members = append(team.Members, models.TeamMember{
+			UserID:   userID,
+			Username: field(record, "username"),
+			IsActive: isActive,
+		})
+	}
+
+	return teams, nil
+}
+
+func check(teams []*models.Team) error {
+	seenTeams := make(map[string]bool, len(teams))
+	seenUsers := make(map[string]string)
+
+	for _, team := range teams {
+		if team.TeamName == "" {
+			return errors.New("team_name is required")
+		}
+		if seenTeams[team.TeamName] {
+			return fmt.Errorf("team %q is listed more than once", team.TeamName)
+		}
+		seenTeams[team.TeamName] = true
+
+		if team.ParentTeamName == team.TeamName {
+			return fmt.Errorf("team %q cannot be its own parent", team.TeamName)
+		}
+
+		for _, m := range team.Members {
+			if m.UserID == "" || m.Username == "" {
+				return fmt.Errorf("team %q: user_id and username are required", team.TeamName)
+			}
+			if other, ok := seenUsers[m.UserID]; ok && other == team.TeamName {
+				return fmt.Errorf("team %q lists user %q more than once", team.TeamName, m.UserID)
+			} else if ok {
+				return fmt.Errorf("user %q is listed in both %q and %q", m.UserID, other, team.TeamName)
+			}
+			seenUsers[m.UserID] = team.TeamName
+		}
+	}
+
+	return nil
+}
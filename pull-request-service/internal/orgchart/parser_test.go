@@ -0,0 +1,222 @@
+package orgchart_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pull-request-service/internal/models"
+	"pull-request-service/internal/orgchart"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		doc    string
+		format models.OrgChartFormat
+		want   []*models.Team
+	}{
+		{
+			name: "yaml members are active by default",
+			doc: `
+teams:
+  - team_name: " backend "
+    parent_team_name: engineering
+    members:
+      - user_id: u1
+        username: Alice
+      - user_id: u2
+        username: Bob
+        is_active: false
+  - team_name: engineering
+`,
+			format: models.OrgChartYAML,
+			want: []*models.Team{
+				{TeamName: "backend", ParentTeamName: "engineering", Members: []models.TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+					{UserID: "u2", Username: "Bob", IsActive: false},
+				}},
+				{TeamName: "engineering", Members: []models.TeamMember{}},
+			},
+		},
+		{
+			name:   "empty yaml document",
+			doc:    "",
+			format: "",
+			want:   []*models.Team{},
+		},
+		{
+			name: "csv groups rows by team",
+			doc: `Team_Name, user_id, username, parent_team_name, is_active
+engineering,,,,
+backend,u1,Alice,engineering,
+backend,u2,Bob,,false
+`,
+			format: models.OrgChartCSV,
+			want: []*models.Team{
+				{TeamName: "engineering", Members: []models.TeamMember{}},
+				{TeamName: "backend", ParentTeamName: "engineering", Members: []models.TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+					{UserID: "u2", Username: "Bob", IsActive: false},
+				}},
+			},
+		},
+		{
+			name: "csv parent given on a later row",
+			doc: `team_name,user_id,username,parent_team_name
+backend,u1,Alice,
+backend,u2,Bob,engineering
+engineering,,,
+`,
+			format: models.OrgChartCSV,
+			want: []*models.Team{
+				{TeamName: "backend", ParentTeamName: "engineering", Members: []models.TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+					{UserID: "u2", Username: "Bob", IsActive: true},
+				}},
+				{TeamName: "engineering", Members: []models.TeamMember{}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams, err := orgchart.Parse(strings.NewReader(tt.doc), tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, teams)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		format  models.OrgChartFormat
+		wantErr string
+	}{
+		{
+			name:    "unsupported format",
+			doc:     "{}",
+			format:  "json",
+			wantErr: "unsupported format",
+		},
+		{
+			name:    "malformed yaml",
+			doc:     "teams: [",
+			format:  models.OrgChartYAML,
+			wantErr: "decoding yaml",
+		},
+		{
+			name: "yaml is_active is not a boolean",
+			doc: `
+teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: Alice
+        is_active: sometimes
+`,
+			format:  models.OrgChartYAML,
+			wantErr: "decoding yaml",
+		},
+		{
+			name: "yaml user in two teams",
+			doc: `
+teams:
+  - team_name: a
+    members: [{user_id: u1, username: Alice}]
+  - team_name: b
+    members: [{user_id: u1, username: Alice}]
+`,
+			format:  models.OrgChartYAML,
+			wantErr: `user "u1" is listed in both "a" and "b"`,
+		},
+		{
+			name: "yaml team listed twice",
+			doc: `
+teams:
+  - team_name: a
+  - team_name: a
+`,
+			format:  models.OrgChartYAML,
+			wantErr: `team "a" is listed more than once`,
+		},
+		{
+			name: "yaml team is its own parent",
+			doc: `
+teams:
+  - team_name: a
+    parent_team_name: a
+`,
+			format:  models.OrgChartYAML,
+			wantErr: "cannot be its own parent",
+		},
+		{
+			name: "yaml member without username",
+			doc: `
+teams:
+  - team_name: a
+    members: [{user_id: u1}]
+`,
+			format:  models.OrgChartYAML,
+			wantErr: "user_id and username are required",
+		},
+		{
+			name:    "csv missing required column",
+			doc:     "team_name,user_id\nbackend,u1\n",
+			format:  models.OrgChartCSV,
+			wantErr: `missing column "username"`,
+		},
+		{
+			name:    "csv empty document",
+			doc:     "",
+			format:  models.OrgChartCSV,
+			wantErr: "reading csv header",
+		},
+		{
+			name: "csv conflicting parents",
+			doc: `team_name,parent_team_name,user_id,username
+backend,engineering,u1,Alice
+backend,platform,u2,Bob
+`,
+			format:  models.OrgChartCSV,
+			wantErr: `line 3: team "backend" has conflicting parents`,
+		},
+		{
+			name: "csv invalid is_active",
+			doc: `team_name,user_id,username,is_active
+backend,u1,Alice,yes
+`,
+			format:  models.OrgChartCSV,
+			wantErr: `line 2: invalid is_active "yes"`,
+		},
+		{
+			name: "csv duplicate user in the same team",
+			doc: `team_name,user_id,username
+backend,u1,Alice
+backend,u1,Alice
+`,
+			format:  models.OrgChartCSV,
+			wantErr: `team "backend" lists user "u1" more than once`,
+		},
+		{
+			name: "csv row without team name",
+			doc: `team_name,user_id,username
+,u1,Alice
+`,
+			format:  models.OrgChartCSV,
+			wantErr: "team_name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams, err := orgchart.Parse(strings.NewReader(tt.doc), tt.format)
+			require.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, teams)
+		})
+	}
+}
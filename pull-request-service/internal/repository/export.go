@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"pull-request-service/internal/models"
+	database "pull-request-service/pkg/db"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ExportRepository streams rows to a callback instead of collecting them,
+// so exports of any size are written out as they are read.
+type ExportRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewExportRepository(db *pgxpool.Pool) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+func (repo *ExportRepository) StreamReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query, args := reviewsStatsQuery(q)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("querying reviews stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rs models.ReviewerStats
+		if err := rows.Scan(&rs.UserID, &rs.Username, &rs.TeamName, &rs.IsActive, &rs.ReviewsNumber, &rs.OpenReviews, &rs.MergedReviews); err != nil {
+			return fmt.Errorf("scanning user reviews stats: %w", err)
+		}
+		if err := fn(&rs); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("reading reviews stats: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ExportRepository) StreamPullRequests(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			pr.pull_request_id, 
+			pr.pull_request_name, 
+			pr.author_id, 
+			pr.status, 
+			pr.created_at, 
+			pr.merged_at, 
+			pr.version,
+			COALESCE(array_agg(prr.user_id ORDER BY prr.id) FILTER (WHERE prr.user_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		LEFT JOIN pr_reviewers prr ON prr.pull_request_id = pr.pull_request_id
+		WHERE pr.deleted_at IS NULL
+			AND ($1::timestamptz IS NULL OR pr.created_at >= $1)
+			AND ($2::timestamptz IS NULL OR pr.created_at < $2)
+			AND ($3 = '' OR pr.status = $3)
+			AND ($4 = '' OR pr.author_id = $4)
+		GROUP BY pr.pull_request_id
+		ORDER BY pr.created_at, pr.pull_request_id
+	`
+
+	rows, err := tx.Query(ctx, query, q.From, q.To, string(q.Status), q.AuthorID)
+	if err != nil {
+		return fmt.Errorf("querying PRs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pr models.PullRequest
+		err := rows.Scan(
+			&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status,
+			&pr.CreatedAt, &pr.MergedAt, &pr.Version, &pr.Assigned,
+		)
+		if err != nil {
+			return fmt.Errorf("scanning PR: %w", err)
+		}
+		if err := fn(&pr); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("reading PRs: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ExportRepository) StreamRoster(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT tm.team_name, u.user_id, u.username, u.is_active, tm.is_primary, u.role
+		FROM team_memberships tm
+		INNER JOIN users u ON u.user_id = tm.user_id
+		WHERE ($1 = '' OR tm.team_name = $1)
+		ORDER BY tm.team_name, u.user_id
+	`
+
+	rows, err := tx.Query(ctx, query, q.TeamName)
+	if err != nil {
+		return fmt.Errorf("querying roster: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e models.RosterEntry
+		if err := rows.Scan(&e.TeamName, &e.UserID, &e.Username, &e.IsActive, &e.IsPrimary, &e.Role); err != nil {
+			return fmt.Errorf("scanning roster entry: %w", err)
+		}
+		if err := fn(&e); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("reading roster: %w", err)
+	}
+
+	return nil
+}
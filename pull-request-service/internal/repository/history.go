@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	database "pull-request-service/pkg/db"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type HistoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewHistoryRepository(db *pgxpool.Pool) *HistoryRepository {
+	return &HistoryRepository{db: db}
+}
+
+func (repo *HistoryRepository) AddPREvent(ctx context.Context, prID, action string, details map[string]any) error {
+	query := `
+		INSERT INTO pull_request_history (pull_request_id, action, details, created_at) 
+		VALUES ($1, $2, $3, $4)
+	`
+
+	now := time.Now().UTC()
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, prID, action, details, now)
+	if err != nil {
+		return fmt.Errorf("adding pull request event: %w", err)
+	}
+
+	return nil
+}
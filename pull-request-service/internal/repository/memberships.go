@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	database "pull-request-service/pkg/db"
+)
+
+// setPrimaryMembership keeps team_memberships in line with users.team_name: the previous primary
+// membership is dropped and teamName, if any, becomes the primary one.
+func setPrimaryMembership(ctx context.Context, tx database.TxOrPool, userID, teamName string) error {
+	dropQuery := `
+		DELETE FROM team_memberships 
+		WHERE user_id=$1 AND is_primary AND team_name<>$2
+	`
+
+	if _, err := tx.Exec(ctx, dropQuery, userID, teamName); err != nil {
+		return fmt.Errorf("dropping primary membership: %w", err)
+	}
+
+	if teamName == "" {
+		return nil
+	}
+
+	upsertQuery := `
+		INSERT INTO team_memberships (user_id, team_name, is_primary) 
+		VALUES ($1, $2, true)
+		ON CONFLICT (user_id, team_name) DO UPDATE
+		SET is_primary = true
+	`
+
+	if _, err := tx.Exec(ctx, upsertQuery, userID, teamName); err != nil {
+		return fmt.Errorf("setting primary membership: %w", err)
+	}
+
+	return nil
+}
@@ -37,16 +37,16 @@ func (repo *PullRequestRepository) CreatePR(ctx context.Context, pr *models.Pull
 
 func (repo *PullRequestRepository) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
 	query := `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at 
+		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, version 
 		FROM pull_requests 
-		WHERE pull_request_id=$1
+		WHERE pull_request_id=$1 AND deleted_at IS NULL
 	`
 
 	var p models.PullRequest
 	tx := database.GetTx(ctx, repo.db)
 
 	err := tx.QueryRow(ctx, query, prID).
-		Scan(&p.PullRequestID, &p.PullRequestName, &p.AuthorID, &p.Status, &p.CreatedAt, &p.MergedAt)
+		Scan(&p.PullRequestID, &p.PullRequestName, &p.AuthorID, &p.Status, &p.CreatedAt, &p.MergedAt, &p.Version)
 	if err != nil {
 		return nil, fmt.Errorf("getting pull request: %w", err)
 	}
@@ -54,11 +54,85 @@ func (repo *PullRequestRepository) GetPR(ctx context.Context, prID string) (*mod
 	return &p, nil
 }
 
-func (repo *PullRequestRepository) MergePR(ctx context.Context, prID string) error {
+func (repo *PullRequestRepository) GetPRForUpdate(ctx context.Context, prID string) (*models.PullRequest, error) {
+	query := `
+		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, version 
+		FROM pull_requests 
+		WHERE pull_request_id=$1 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+
+	var p models.PullRequest
+	tx := database.GetTx(ctx, repo.db)
+
+	err := tx.QueryRow(ctx, query, prID).
+		Scan(&p.PullRequestID, &p.PullRequestName, &p.AuthorID, &p.Status, &p.CreatedAt, &p.MergedAt, &p.Version)
+	if err != nil {
+		return nil, fmt.Errorf("locking pull request: %w", err)
+	}
+
+	return &p, nil
+}
+
+func (repo *PullRequestRepository) BumpVersion(ctx context.Context, prID string) error {
 	query := `
 		UPDATE pull_requests 
-		SET status='MERGED', merged_at=COALESCE(merged_at,$1) 
-		WHERE pull_request_id=$2
+		SET version=version+1 
+		WHERE pull_request_id=$1
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+
+	_, err := tx.Exec(ctx, query, prID)
+	if err != nil {
+		return fmt.Errorf("bumping pull request version: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *PullRequestRepository) PRExists(ctx context.Context, prID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM pull_requests 
+			WHERE pull_request_id=$1
+		)
+	`
+
+	var exists bool
+	tx := database.GetTx(ctx, repo.db)
+
+	err := tx.QueryRow(ctx, query, prID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking pull request existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// MergePR marks the PR merged and, if it was open, moves its reviewers' counters from open to merged.
+func (repo *PullRequestRepository) MergePR(ctx context.Context, prID string) error {
+	query := `
+		WITH merging AS (
+			SELECT pull_request_id 
+			FROM pull_requests 
+			WHERE pull_request_id=$2 AND deleted_at IS NULL AND status='OPEN'
+		), merged AS (
+			UPDATE pull_requests 
+			SET 
+				status='MERGED', 
+				merged_at=COALESCE(merged_at,$1), 
+				version=CASE WHEN status='MERGED' THEN version ELSE version+1 END 
+			WHERE pull_request_id=$2 AND deleted_at IS NULL
+		)
+		UPDATE reviewer_counters c
+		SET 
+			open_reviews = c.open_reviews - 1, 
+			merged_reviews = c.merged_reviews + 1
+		FROM pr_reviewers prr
+		INNER JOIN merging m ON m.pull_request_id = prr.pull_request_id
+		WHERE c.user_id = prr.user_id
 	`
 
 	now := time.Now().UTC()
@@ -71,3 +145,37 @@ func (repo *PullRequestRepository) MergePR(ctx context.Context, prID string) err
 
 	return nil
 }
+
+func (repo *PullRequestRepository) SoftDeletePR(ctx context.Context, prID string) error {
+	query := `
+		UPDATE pull_requests 
+		SET deleted_at=$1, version=version+1 
+		WHERE pull_request_id=$2 AND deleted_at IS NULL
+	`
+
+	now := time.Now().UTC()
+	tx := database.GetTx(ctx, repo.db)
+
+	_, err := tx.Exec(ctx, query, now, prID)
+	if err != nil {
+		return fmt.Errorf("soft deleting pull request: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *PullRequestRepository) DeletePR(ctx context.Context, prID string) error {
+	query := `
+		DELETE FROM pull_requests 
+		WHERE pull_request_id=$1
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+
+	_, err := tx.Exec(ctx, query, prID)
+	if err != nil {
+		return fmt.Errorf("deleting pull request: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"pull-request-service/internal/models"
 	database "pull-request-service/pkg/db"
@@ -18,14 +19,52 @@ func NewReviewRepository(db *pgxpool.Pool) *ReviewRepository {
 	return &ReviewRepository{db: db}
 }
 
+// releaseReviewerCounters finishes a statement whose "removed" CTE deletes pr_reviewers rows,
+// taking the removed assignments off the reviewer counters in the same statement.
+const releaseReviewerCounters = `
+	, released AS (
+		SELECT 
+			r.user_id,
+			COUNT(*) AS reviews,
+			COUNT(*) FILTER (WHERE pr.status='OPEN') AS open_reviews,
+			COUNT(*) FILTER (WHERE pr.status='MERGED') AS merged_reviews
+		FROM removed r
+		INNER JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+		WHERE pr.deleted_at IS NULL
+		GROUP BY r.user_id
+	)
+	UPDATE reviewer_counters c
+	SET 
+		reviews = c.reviews - rel.reviews,
+		open_reviews = c.open_reviews - rel.open_reviews,
+		merged_reviews = c.merged_reviews - rel.merged_reviews
+	FROM released rel
+	WHERE c.user_id = rel.user_id
+`
+
+// AddReviewer assigns a reviewer and bumps their counters in the same statement.
 func (repo *ReviewRepository) AddReviewer(ctx context.Context, prID, userID string) error {
 	query := `
-		INSERT INTO pr_reviewers (pull_request_id, user_id) 
-		VALUES ($1, $2)
+		WITH added AS (
+			INSERT INTO pr_reviewers (pull_request_id, user_id, assigned_at) 
+			VALUES ($1, $2, $3)
+			RETURNING pull_request_id, user_id
+		)
+		INSERT INTO reviewer_counters (user_id, reviews, open_reviews, merged_reviews)
+		SELECT a.user_id, 1, (pr.status='OPEN')::int, (pr.status='MERGED')::int
+		FROM added a
+		INNER JOIN pull_requests pr ON pr.pull_request_id = a.pull_request_id
+		WHERE pr.deleted_at IS NULL
+		ON CONFLICT (user_id) DO UPDATE 
+		SET 
+			reviews = reviewer_counters.reviews + EXCLUDED.reviews,
+			open_reviews = reviewer_counters.open_reviews + EXCLUDED.open_reviews,
+			merged_reviews = reviewer_counters.merged_reviews + EXCLUDED.merged_reviews
 	`
 
+	now := time.Now().UTC()
 	tx := database.GetTx(ctx, repo.db)
-	_, err := tx.Exec(ctx, query, prID, userID)
+	_, err := tx.Exec(ctx, query, prID, userID, now)
 	if err != nil {
 		return fmt.Errorf("adding reviewer: %w", err)
 	}
@@ -35,9 +74,11 @@ func (repo *ReviewRepository) AddReviewer(ctx context.Context, prID, userID stri
 
 func (repo *ReviewRepository) RemoveReviewer(ctx context.Context, prID, userID string) error {
 	query := `
-		DELETE FROM pr_reviewers 
-		WHERE pull_request_id=$1 AND user_id=$2
-	`
+		WITH removed AS (
+			DELETE FROM pr_reviewers 
+			WHERE pull_request_id=$1 AND user_id=$2
+			RETURNING pull_request_id, user_id
+		)` + releaseReviewerCounters
 
 	tx := database.GetTx(ctx, repo.db)
 	_, err := tx.Exec(ctx, query, prID, userID)
@@ -48,6 +89,44 @@ func (repo *ReviewRepository) RemoveReviewer(ctx context.Context, prID, userID s
 	return nil
 }
 
+func (repo *ReviewRepository) RemoveAllReviewers(ctx context.Context, prID string) error {
+	query := `
+		WITH removed AS (
+			DELETE FROM pr_reviewers 
+			WHERE pull_request_id=$1
+			RETURNING pull_request_id, user_id
+		)` + releaseReviewerCounters
+
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, prID)
+	if err != nil {
+		return fmt.Errorf("removing reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ReviewRepository) StartReview(ctx context.Context, prID, userID string) (*models.ReviewAssignment, error) {
+	query := `
+		UPDATE pr_reviewers 
+		SET started_at=COALESCE(started_at, $3) 
+		WHERE pull_request_id=$1 AND user_id=$2
+		RETURNING pull_request_id, user_id, assigned_at, started_at
+	`
+
+	var ra models.ReviewAssignment
+	now := time.Now().UTC()
+	tx := database.GetTx(ctx, repo.db)
+
+	err := tx.QueryRow(ctx, query, prID, userID, now).
+		Scan(&ra.PullRequestID, &ra.UserID, &ra.AssignedAt, &ra.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("starting review: %w", err)
+	}
+
+	return &ra, nil
+}
+
 func (repo *ReviewRepository) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
 	query := `
 		SELECT user_id 
@@ -103,16 +182,12 @@ func (repo *ReviewRepository) GetPRsByReviewer(ctx context.Context, userID strin
 	return prs, nil
 }
 
-func (repo *ReviewRepository) GetReviewsStats(ctx context.Context) ([]*models.ReviewerStats, error) {
+func (repo *ReviewRepository) GetReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error) {
 	tx := database.GetTx(ctx, repo.db)
 
-	query := `
-		SELECT user_id, COUNT(*)
-		FROM pr_reviewers 
-		GROUP BY user_id
-	`
+	query, args := reviewsStatsQuery(q)
 
-	rows, err := tx.Query(ctx, query)
+	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying reviews stats: %w", err)
 	}
@@ -121,7 +196,7 @@ func (repo *ReviewRepository) GetReviewsStats(ctx context.Context) ([]*models.Re
 	var rsl []*models.ReviewerStats
 	for rows.Next() {
 		var rs models.ReviewerStats
-		if err := rows.Scan(&rs.UserID, &rs.ReviewsNumber); err != nil {
+		if err := rows.Scan(&rs.UserID, &rs.Username, &rs.TeamName, &rs.IsActive, &rs.ReviewsNumber, &rs.OpenReviews, &rs.MergedReviews); err != nil {
 			return nil, fmt.Errorf("scanning user reviews stats: %w", err)
 		}
 		rsl = append(rsl, &rs)
@@ -129,3 +204,213 @@ func (repo *ReviewRepository) GetReviewsStats(ctx context.Context) ([]*models.Re
 
 	return rsl, nil
 }
+
+// reviewsStatsQuery builds the per-user stats query; it is shared with the CSV export.
+// Rows start from users so active members without reviews are listed with zeros; inactive
+// users only appear when they have matching reviews. A team filter follows primary membership:
+// reviews count when the reviewer's primary team at assignment matches, idle members are the
+// team's current primary members, and every row reports the filtered team. Without filters the
+// totals are read from reviewer_counters instead of aggregating pr_reviewers.
+func reviewsStatsQuery(q *models.ReviewsStatsQuery) (string, []any) {
+	if q.From == nil && q.To == nil && q.TeamName == "" && q.Status == "" {
+		return `
+			SELECT 
+				u.user_id, 
+				u.username, 
+				COALESCE(u.team_name, ''), 
+				u.is_active,
+				COALESCE(c.reviews, 0),
+				COALESCE(c.open_reviews, 0),
+				COALESCE(c.merged_reviews, 0)
+			FROM users u
+			LEFT JOIN reviewer_counters c ON c.user_id = u.user_id
+			WHERE u.is_active OR c.reviews > 0
+			ORDER BY u.user_id
+		`, nil
+	}
+
+	reviews := `
+		SELECT 
+			prr.user_id, 
+			COUNT(*) AS reviews,
+			COUNT(*) FILTER (WHERE pr.status='OPEN') AS open_reviews,
+			COUNT(*) FILTER (WHERE pr.status='MERGED') AS merged_reviews
+		FROM pr_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		INNER JOIN users ru ON ru.user_id = prr.user_id
+		WHERE pr.deleted_at IS NULL
+	`
+	var args []any
+
+	if q.From != nil {
+		args = append(args, *q.From)
+		reviews += fmt.Sprintf(` AND prr.assigned_at >= $%d`, len(args))
+	}
+	if q.To != nil {
+		args = append(args, *q.To)
+		reviews += fmt.Sprintf(` AND prr.assigned_at < $%d`, len(args))
+	}
+	if q.Status != "" {
+		args = append(args, q.Status)
+		reviews += fmt.Sprintf(` AND pr.status = $%d`, len(args))
+	}
+
+	members := `u.is_active`
+	teamColumn := `COALESCE(u.team_name, '')`
+	if q.TeamName != "" {
+		args = append(args, q.TeamName)
+		reviews += fmt.Sprintf(` AND COALESCE((
+			SELECT h.team_name
+			FROM team_membership_history h
+			WHERE h.user_id = prr.user_id
+				AND (h.valid_from IS NULL OR h.valid_from <= prr.assigned_at)
+				AND (h.valid_to IS NULL OR h.valid_to > prr.assigned_at)
+			ORDER BY h.valid_from DESC NULLS LAST
+			LIMIT 1
+		), ru.team_name) = $%d`, len(args))
+		members += fmt.Sprintf(` AND u.team_name = $%d`, len(args))
+		teamColumn = fmt.Sprintf(`$%d::text`, len(args))
+	}
+
+	reviews += ` GROUP BY prr.user_id`
+
+	query := `
+		WITH reviews AS (` + reviews + `)
+		SELECT 
+			u.user_id, 
+			u.username, 
+			` + teamColumn + `, 
+			u.is_active,
+			COALESCE(r.reviews, 0),
+			COALESCE(r.open_reviews, 0),
+			COALESCE(r.merged_reviews, 0)
+		FROM users u
+		LEFT JOIN reviews r ON r.user_id = u.user_id
+		WHERE r.user_id IS NOT NULL OR (` + members + `)
+		ORDER BY u.user_id
+	`
+
+	return query, args
+}
+
+func (repo *ReviewRepository) GetUserPickupStats(ctx context.Context) ([]*models.UserPickupStats, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			prr.user_id,
+			COUNT(prr.started_at),
+			COUNT(*) FILTER (WHERE prr.started_at IS NULL AND pr.status='OPEN'),
+			COALESCE(AVG(EXTRACT(EPOCH FROM prr.started_at - prr.assigned_at)), 0)::float8,
+			COALESCE(AVG(EXTRACT(EPOCH FROM $1::timestamptz - prr.assigned_at)) 
+				FILTER (WHERE prr.started_at IS NULL AND pr.status='OPEN'), 0)::float8
+		FROM pr_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		GROUP BY prr.user_id
+		ORDER BY prr.user_id
+	`
+
+	rows, err := tx.Query(ctx, query, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("querying user pickup stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*models.UserPickupStats
+	for rows.Next() {
+		var ps models.UserPickupStats
+		err := rows.Scan(&ps.UserID, &ps.PickedUp, &ps.Pending, &ps.AvgTimeToPickupSeconds, &ps.AvgIdleSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("scanning user pickup stats: %w", err)
+		}
+		stats = append(stats, &ps)
+	}
+
+	return stats, nil
+}
+
+func (repo *ReviewRepository) GetTeamPickupStats(ctx context.Context) ([]*models.TeamPickupStats, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			COALESCE(mh.team_name, u.team_name) AS team,
+			COUNT(prr.started_at),
+			COUNT(*) FILTER (WHERE prr.started_at IS NULL AND pr.status='OPEN'),
+			COALESCE(AVG(EXTRACT(EPOCH FROM prr.started_at - prr.assigned_at)), 0)::float8,
+			COALESCE(AVG(EXTRACT(EPOCH FROM $1::timestamptz - prr.assigned_at)) 
+				FILTER (WHERE prr.started_at IS NULL AND pr.status='OPEN'), 0)::float8
+		FROM pr_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		INNER JOIN users u ON u.user_id = prr.user_id
+		LEFT JOIN LATERAL (
+			SELECT h.team_name
+			FROM team_membership_history h
+			WHERE h.user_id = prr.user_id
+				AND (h.valid_from IS NULL OR h.valid_from <= prr.assigned_at)
+				AND (h.valid_to IS NULL OR h.valid_to > prr.assigned_at)
+			ORDER BY h.valid_from DESC NULLS LAST
+			LIMIT 1
+		) mh ON true
+		WHERE COALESCE(mh.team_name, u.team_name) IS NOT NULL
+		GROUP BY team
+		ORDER BY team
+	`
+
+	rows, err := tx.Query(ctx, query, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("querying team pickup stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*models.TeamPickupStats
+	for rows.Next() {
+		var ps models.TeamPickupStats
+		err := rows.Scan(&ps.TeamName, &ps.PickedUp, &ps.Pending, &ps.AvgTimeToPickupSeconds, &ps.AvgIdleSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("scanning team pickup stats: %w", err)
+		}
+		stats = append(stats, &ps)
+	}
+
+	return stats, nil
+}
+
+// GetMembersWorkload returns the current review workload of the given users in one aggregated query.
+func (repo *ReviewRepository) GetMembersWorkload(ctx context.Context, userIDs []string) (map[string]*models.MemberWorkload, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			u.user_id,
+			COUNT(prr.id) FILTER (WHERE pr.status='OPEN'),
+			EXTRACT(EPOCH FROM $2::timestamptz - MIN(prr.assigned_at) FILTER (WHERE pr.status='OPEN'))::float8,
+			COUNT(prr.id) FILTER (WHERE prr.assigned_at >= $2::timestamptz - INTERVAL '30 days'),
+			NOT u.is_active,
+			u.inactive_until
+		FROM users u
+		LEFT JOIN pr_reviewers prr ON prr.user_id = u.user_id
+		LEFT JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id AND pr.deleted_at IS NULL
+		WHERE u.user_id = ANY($1)
+		GROUP BY u.user_id, u.is_active, u.inactive_until
+	`
+
+	rows, err := tx.Query(ctx, query, userIDs, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("querying members workload: %w", err)
+	}
+	defer rows.Close()
+
+	workload := make(map[string]*models.MemberWorkload, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var w models.MemberWorkload
+		err := rows.Scan(&userID, &w.OpenReviews, &w.OldestOpenReviewAgeSeconds, &w.ReviewsLast30Days, &w.IsAbsent, &w.AbsentUntil)
+		if err != nil {
+			return nil, fmt.Errorf("scanning member workload: %w", err)
+		}
+		workload[userID] = &w
+	}
+
+	return workload, nil
+}
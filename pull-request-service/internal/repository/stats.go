@@ -0,0 +1,561 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pull-request-service/internal/models"
+	database "pull-request-service/pkg/db"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StatsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewStatsRepository(db *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+var cycleTimeKeys = map[models.CycleTimeGroupBy]string{
+	models.CycleTimeByTeam:   `COALESCE(mh.team_name, u.team_name, '')`,
+	models.CycleTimeByAuthor: `pr.author_id`,
+	models.CycleTimeByWeek:   `to_char(date_trunc('week', pr.created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD')`,
+}
+
+// GetCycleTime computes time-to-merge and time-to-first-review percentiles in the database.
+// The first review is the earliest pickup (started_at) among the PR's reviewers.
+func (repo *StatsRepository) GetCycleTime(ctx context.Context, q *models.CycleTimeQuery) ([]*models.CycleTimeStats, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	key, ok := cycleTimeKeys[q.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported group by %q", q.GroupBy)
+	}
+
+	query := `
+		WITH prs AS (
+			SELECT 
+				` + key + ` AS key,
+				EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::float8 AS time_to_merge,
+				EXTRACT(EPOCH FROM fr.started_at - pr.created_at)::float8 AS time_to_first_review
+			FROM pull_requests pr
+			LEFT JOIN users u ON u.user_id = pr.author_id
+			LEFT JOIN LATERAL (
+				SELECT h.team_name
+				FROM team_membership_history h
+				WHERE h.user_id = pr.author_id
+					AND (h.valid_from IS NULL OR h.valid_from <= pr.created_at)
+					AND (h.valid_to IS NULL OR h.valid_to > pr.created_at)
+				ORDER BY h.valid_from DESC NULLS LAST
+				LIMIT 1
+			) mh ON true
+			LEFT JOIN LATERAL (
+				SELECT MIN(prr.started_at) AS started_at
+				FROM pr_reviewers prr
+				WHERE prr.pull_request_id = pr.pull_request_id
+			) fr ON true
+			WHERE pr.deleted_at IS NULL 
+				AND pr.created_at IS NOT NULL
+				AND ($1::timestamptz IS NULL OR pr.created_at >= $1)
+				AND ($2::timestamptz IS NULL OR pr.created_at < $2)
+		)
+		SELECT 
+			key,
+			COUNT(*),
+			COUNT(time_to_merge),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY time_to_merge),
+			percentile_cont(0.9) WITHIN GROUP (ORDER BY time_to_merge),
+			percentile_cont(0.99) WITHIN GROUP (ORDER BY time_to_merge),
+			COUNT(time_to_first_review),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY time_to_first_review),
+			percentile_cont(0.9) WITHIN GROUP (ORDER BY time_to_first_review),
+			percentile_cont(0.99) WITHIN GROUP (ORDER BY time_to_first_review)
+		FROM prs
+		GROUP BY key
+		ORDER BY key
+	`
+
+	rows, err := tx.Query(ctx, query, q.From, q.To)
+	if err != nil {
+		return nil, fmt.Errorf("querying cycle time: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []*models.CycleTimeStats{}
+	for rows.Next() {
+		var s models.CycleTimeStats
+		err := rows.Scan(
+			&s.Key, &s.PRsCreated,
+			&s.PRsMerged, &s.TimeToMerge.P50, &s.TimeToMerge.P90, &s.TimeToMerge.P99,
+			&s.PRsReviewed, &s.TimeToFirstReview.P50, &s.TimeToFirstReview.P90, &s.TimeToFirstReview.P99,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning cycle time: %w", err)
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, nil
+}
+
+func (repo *StatsRepository) CountOpenPRs(ctx context.Context) (int, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT COUNT(*) 
+		FROM pull_requests 
+		WHERE status='OPEN' AND deleted_at IS NULL
+	`
+
+	var n int
+	if err := tx.QueryRow(ctx, query).Scan(&n); err != nil {
+		return 0, fmt.Errorf("counting open PRs: %w", err)
+	}
+
+	return n, nil
+}
+
+// CountOpenReviewsByTeam counts reviews on open PRs by the reviewer's current primary team.
+func (repo *StatsRepository) CountOpenReviewsByTeam(ctx context.Context) (map[string]int, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT COALESCE(u.team_name, ''), COUNT(*)
+		FROM pr_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		INNER JOIN users u ON u.user_id = prr.user_id
+		WHERE pr.status='OPEN' AND pr.deleted_at IS NULL
+		GROUP BY 1
+	`
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("counting open reviews: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var team string
+		var n int
+		if err := rows.Scan(&team, &n); err != nil {
+			return nil, fmt.Errorf("scanning open reviews: %w", err)
+		}
+		counts[team] = n
+	}
+
+	return counts, nil
+}
+
+// GetMemberAssignments counts assignments per team member in the window, including members with none.
+// An assignment belongs to the team the reviewer was in at assigned_at; users who were never moved
+// have no history rows and count as members of their current team for the whole window.
+func (repo *StatsRepository) GetMemberAssignments(ctx context.Context, q *models.FairnessQuery) ([]*models.MemberAssignments, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		WITH periods AS (
+			SELECT h.user_id, h.team_name, h.valid_from, h.valid_to
+			FROM team_membership_history h
+			UNION ALL
+			SELECT u.user_id, u.team_name, NULL, NULL
+			FROM users u
+			WHERE u.team_name IS NOT NULL
+				AND NOT EXISTS (
+					SELECT 1 FROM team_membership_history h 
+					WHERE h.user_id = u.user_id AND h.valid_to IS NULL
+				)
+		), members AS (
+			SELECT DISTINCT p.team_name, p.user_id
+			FROM periods p
+			WHERE ($1::timestamptz IS NULL OR p.valid_to IS NULL OR p.valid_to > $1)
+				AND ($2::timestamptz IS NULL OR p.valid_from IS NULL OR p.valid_from < $2)
+				AND ($3 = '' OR p.team_name = $3)
+		), assignments AS (
+			SELECT 
+				COALESCE(mh.team_name, u.team_name) AS team_name,
+				prr.user_id,
+				COUNT(*) AS n
+			FROM pr_reviewers prr
+			INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			INNER JOIN users u ON u.user_id = prr.user_id
+			LEFT JOIN LATERAL (
+				SELECT h.team_name
+				FROM team_membership_history h
+				WHERE h.user_id = prr.user_id
+					AND (h.valid_from IS NULL OR h.valid_from <= prr.assigned_at)
+					AND (h.valid_to IS NULL OR h.valid_to > prr.assigned_at)
+				ORDER BY h.valid_from DESC NULLS LAST
+				LIMIT 1
+			) mh ON true
+			WHERE pr.deleted_at IS NULL
+				AND ($1::timestamptz IS NULL OR prr.assigned_at >= $1)
+				AND ($2::timestamptz IS NULL OR prr.assigned_at < $2)
+			GROUP BY 1, 2
+		)
+		SELECT m.team_name, m.user_id, u.username, COALESCE(a.n, 0)
+		FROM members m
+		INNER JOIN teams t ON t.team_name = m.team_name
+		INNER JOIN users u ON u.user_id = m.user_id
+		LEFT JOIN assignments a ON a.team_name = m.team_name AND a.user_id = m.user_id
+		WHERE u.is_active AND u.offboarded_at IS NULL
+		ORDER BY m.team_name, m.user_id
+	`
+
+	rows, err := tx.Query(ctx, query, q.From, q.To, q.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("querying member assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []*models.MemberAssignments
+	for rows.Next() {
+		var ma models.MemberAssignments
+		if err := rows.Scan(&ma.TeamName, &ma.UserID, &ma.Username, &ma.Assignments); err != nil {
+			return nil, fmt.Errorf("scanning member assignments: %w", err)
+		}
+		assignments = append(assignments, &ma)
+	}
+
+	return assignments, nil
+}
+
+// reviewerCountersSource recomputes reviewer counters from scratch.
+const reviewerCountersSource = `
+	SELECT 
+		prr.user_id,
+		COUNT(*) AS reviews,
+		COUNT(*) FILTER (WHERE pr.status='OPEN') AS open_reviews,
+		COUNT(*) FILTER (WHERE pr.status='MERGED') AS merged_reviews
+	FROM pr_reviewers prr
+	INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+	WHERE pr.deleted_at IS NULL
+	GROUP BY prr.user_id
+`
+
+// LockReviewerCounters blocks counter updates until the transaction ends, so a check or rebuild
+// sees pr_reviewers and reviewer_counters in step.
+func (repo *StatsRepository) LockReviewerCounters(ctx context.Context) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	if _, err := tx.Exec(ctx, `LOCK TABLE reviewer_counters IN EXCLUSIVE MODE`); err != nil {
+		return fmt.Errorf("locking reviewer counters: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *StatsRepository) DiffReviewerCounters(ctx context.Context) ([]*models.CounterDrift, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		WITH actual AS (` + reviewerCountersSource + `)
+		SELECT 
+			COALESCE(c.user_id, a.user_id),
+			COALESCE(c.reviews, 0), COALESCE(c.open_reviews, 0), COALESCE(c.merged_reviews, 0),
+			COALESCE(a.reviews, 0), COALESCE(a.open_reviews, 0), COALESCE(a.merged_reviews, 0)
+		FROM reviewer_counters c
+		FULL JOIN actual a ON a.user_id = c.user_id
+		WHERE (COALESCE(c.reviews, 0), COALESCE(c.open_reviews, 0), COALESCE(c.merged_reviews, 0)) 
+			<> (COALESCE(a.reviews, 0), COALESCE(a.open_reviews, 0), COALESCE(a.merged_reviews, 0))
+		ORDER BY 1
+	`
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("diffing reviewer counters: %w", err)
+	}
+	defer rows.Close()
+
+	drift := []*models.CounterDrift{}
+	for rows.Next() {
+		var d models.CounterDrift
+		err := rows.Scan(
+			&d.UserID,
+			&d.Stored.Reviews, &d.Stored.OpenReviews, &d.Stored.MergedReviews,
+			&d.Actual.Reviews, &d.Actual.OpenReviews, &d.Actual.MergedReviews,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning counter drift: %w", err)
+		}
+		drift = append(drift, &d)
+	}
+
+	return drift, nil
+}
+
+func (repo *StatsRepository) RebuildReviewerCounters(ctx context.Context) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM reviewer_counters`); err != nil {
+		return fmt.Errorf("clearing reviewer counters: %w", err)
+	}
+
+	query := `
+		INSERT INTO reviewer_counters (user_id, reviews, open_reviews, merged_reviews)
+	` + reviewerCountersSource
+
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return fmt.Errorf("rebuilding reviewer counters: %w", err)
+	}
+
+	return nil
+}
+
+// GetOpenPullRequests lists open PRs authored by current members of the team.
+func (repo *StatsRepository) GetOpenPullRequests(ctx context.Context, teamName string) ([]*models.OpenPullRequest, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			pr.pull_request_id, 
+			pr.pull_request_name, 
+			pr.author_id, 
+			pr.created_at,
+			COALESCE(array_agg(prr.user_id ORDER BY prr.id) FILTER (WHERE prr.user_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		LEFT JOIN pr_reviewers prr ON prr.pull_request_id = pr.pull_request_id
+		WHERE pr.status='OPEN' AND pr.deleted_at IS NULL
+			AND EXISTS (
+				SELECT 1 FROM team_memberships tm 
+				WHERE tm.user_id = pr.author_id AND tm.team_name = $1
+			)
+		GROUP BY pr.pull_request_id
+		ORDER BY pr.created_at NULLS LAST, pr.pull_request_id
+	`
+
+	rows, err := tx.Query(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("querying open PRs: %w", err)
+	}
+	defer rows.Close()
+
+	prs := []*models.OpenPullRequest{}
+	for rows.Next() {
+		var pr models.OpenPullRequest
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.CreatedAt, &pr.Reviewers); err != nil {
+			return nil, fmt.Errorf("scanning open PR: %w", err)
+		}
+		prs = append(prs, &pr)
+	}
+
+	return prs, nil
+}
+
+// GetOverdueReviews lists assignments of current team members on open PRs that were made before
+// assignedBefore and have not been picked up yet.
+func (repo *StatsRepository) GetOverdueReviews(ctx context.Context, teamName string, assignedBefore time.Time) ([]*models.OverdueReview, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			pr.pull_request_id, 
+			pr.pull_request_name, 
+			prr.user_id, 
+			u.username, 
+			prr.assigned_at,
+			EXTRACT(EPOCH FROM now() - prr.assigned_at)::float8
+		FROM pr_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		INNER JOIN users u ON u.user_id = prr.user_id
+		WHERE pr.status='OPEN' AND pr.deleted_at IS NULL
+			AND prr.started_at IS NULL
+			AND prr.assigned_at < $2
+			AND EXISTS (
+				SELECT 1 FROM team_memberships tm 
+				WHERE tm.user_id = prr.user_id AND tm.team_name = $1
+			)
+		ORDER BY prr.assigned_at, prr.id
+	`
+
+	rows, err := tx.Query(ctx, query, teamName, assignedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("querying overdue reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := []*models.OverdueReview{}
+	for rows.Next() {
+		var r models.OverdueReview
+		err := rows.Scan(&r.PullRequestID, &r.PullRequestName, &r.UserID, &r.Username, &r.AssignedAt, &r.WaitingSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("scanning overdue review: %w", err)
+		}
+		reviews = append(reviews, &r)
+	}
+
+	return reviews, nil
+}
+
+// GetDailyActivity returns one row per UTC day from since to today, including days without activity.
+// Both series count reviews: those assigned that day and those whose PR was merged that day, each
+// attributed to the team the reviewer was in at that moment.
+func (repo *StatsRepository) GetDailyActivity(ctx context.Context, teamName string, since time.Time) ([]*models.DailyActivity, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		WITH days AS (
+			SELECT generate_series(
+				($2::timestamptz AT TIME ZONE 'UTC')::date, 
+				(now() AT TIME ZONE 'UTC')::date, 
+				interval '1 day'
+			)::date AS day
+		), assigned AS (
+			SELECT (prr.assigned_at AT TIME ZONE 'UTC')::date AS day, COUNT(*) AS n
+			FROM pr_reviewers prr
+			INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			INNER JOIN users u ON u.user_id = prr.user_id
+			LEFT JOIN LATERAL (
+				SELECT h.team_name
+				FROM team_membership_history h
+				WHERE h.user_id = prr.user_id
+					AND (h.valid_from IS NULL OR h.valid_from <= prr.assigned_at)
+					AND (h.valid_to IS NULL OR h.valid_to > prr.assigned_at)
+				ORDER BY h.valid_from DESC NULLS LAST
+				LIMIT 1
+			) mh ON true
+			WHERE pr.deleted_at IS NULL
+				AND prr.assigned_at >= $2
+				AND COALESCE(mh.team_name, u.team_name) = $1
+			GROUP BY 1
+		), merged AS (
+			SELECT (pr.merged_at AT TIME ZONE 'UTC')::date AS day, COUNT(*) AS n
+			FROM pr_reviewers prr
+			INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			INNER JOIN users u ON u.user_id = prr.user_id
+			LEFT JOIN LATERAL (
+				SELECT h.team_name
+				FROM team_membership_history h
+				WHERE h.user_id = prr.user_id
+					AND (h.valid_from IS NULL OR h.valid_from <= pr.merged_at)
+					AND (h.valid_to IS NULL OR h.valid_to > pr.merged_at)
+				ORDER BY h.valid_from DESC NULLS LAST
+				LIMIT 1
+			) mh ON true
+			WHERE pr.deleted_at IS NULL
+				AND pr.merged_at >= $2
+				AND COALESCE(mh.team_name, u.team_name) = $1
+			GROUP BY 1
+		)
+		SELECT d.day, COALESCE(a.n, 0), COALESCE(m.n, 0)
+		FROM days d
+		LEFT JOIN assigned a ON a.day = d.day
+		LEFT JOIN merged m ON m.day = d.day
+		ORDER BY d.day
+	`
+
+	rows, err := tx.Query(ctx, query, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("querying daily activity: %w", err)
+	}
+	defer rows.Close()
+
+	activity := []*models.DailyActivity{}
+	for rows.Next() {
+		var a models.DailyActivity
+		if err := rows.Scan(&a.Day, &a.Assigned, &a.Merged); err != nil {
+			return nil, fmt.Errorf("scanning daily activity: %w", err)
+		}
+		activity = append(activity, &a)
+	}
+
+	return activity, nil
+}
+
+// completedOnDayQuery counts the reviews of user %s on PRs merged during the UTC day starting at $1.
+const completedOnDayQuery = `(
+	SELECT COUNT(*)
+	FROM pr_reviewers prr
+	INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+	WHERE prr.user_id = %s
+		AND pr.status = 'MERGED' 
+		AND pr.deleted_at IS NULL
+		AND pr.merged_at >= $1::timestamptz 
+		AND pr.merged_at < $1::timestamptz + interval '1 day'
+)`
+
+// TakeLoadSnapshot records every current user's open reviews, reviews completed that day and primary
+// team for the given UTC day. Taking it again on the same day overwrites the day's rows.
+func (repo *StatsRepository) TakeLoadSnapshot(ctx context.Context, day time.Time) (int, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	start := day.UTC().Truncate(24 * time.Hour)
+
+	query := fmt.Sprintf(`
+		INSERT INTO review_load_snapshots (snapshot_date, user_id, team_name, open_reviews, completed_reviews, taken_at)
+		SELECT 
+			($1::timestamptz AT TIME ZONE 'UTC')::date, 
+			u.user_id, 
+			u.team_name, 
+			COALESCE(c.open_reviews, 0), 
+			%s, 
+			now()
+		FROM users u
+		LEFT JOIN reviewer_counters c ON c.user_id = u.user_id
+		WHERE u.offboarded_at IS NULL
+		ON CONFLICT (snapshot_date, user_id) DO UPDATE 
+		SET 
+			team_name = EXCLUDED.team_name,
+			open_reviews = EXCLUDED.open_reviews,
+			completed_reviews = EXCLUDED.completed_reviews,
+			taken_at = EXCLUDED.taken_at
+	`, fmt.Sprintf(completedOnDayQuery, "u.user_id"))
+
+	tag, err := tx.Exec(ctx, query, start)
+	if err != nil {
+		return 0, fmt.Errorf("taking review load snapshot: %w", err)
+	}
+
+	// Merges after the last snapshot of the previous day are only counted here.
+	refreshQuery := fmt.Sprintf(`
+		UPDATE review_load_snapshots s
+		SET completed_reviews = %s
+		WHERE s.snapshot_date = ($1::timestamptz AT TIME ZONE 'UTC')::date
+	`, fmt.Sprintf(completedOnDayQuery, "s.user_id"))
+
+	if _, err := tx.Exec(ctx, refreshQuery, start.AddDate(0, 0, -1)); err != nil {
+		return 0, fmt.Errorf("refreshing previous review load snapshot: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (repo *StatsRepository) GetLoadTrend(ctx context.Context, q *models.LoadTrendQuery) ([]*models.LoadTrendPoint, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT 
+			s.snapshot_date,
+			COUNT(*),
+			COALESCE(SUM(s.open_reviews), 0),
+			COALESCE(MAX(s.open_reviews), 0),
+			COALESCE(SUM(s.completed_reviews), 0)
+		FROM review_load_snapshots s
+		WHERE ($1 = '' OR s.user_id = $1)
+			AND ($2 = '' OR s.team_name = $2)
+			AND ($3::timestamptz IS NULL OR s.snapshot_date >= ($3::timestamptz AT TIME ZONE 'UTC')::date)
+			AND ($4::timestamptz IS NULL OR s.snapshot_date < ($4::timestamptz AT TIME ZONE 'UTC')::date)
+		GROUP BY s.snapshot_date
+		ORDER BY s.snapshot_date
+	`
+
+	rows, err := tx.Query(ctx, query, q.UserID, q.TeamName, q.From, q.To)
+	if err != nil {
+		return nil, fmt.Errorf("querying load trend: %w", err)
+	}
+	defer rows.Close()
+
+	points := []*models.LoadTrendPoint{}
+	for rows.Next() {
+		var p models.LoadTrendPoint
+		if err := rows.Scan(&p.Day, &p.Members, &p.OpenReviews, &p.MaxOpenReviews, &p.CompletedReviews); err != nil {
+			return nil, fmt.Errorf("scanning load trend: %w", err)
+		}
+		points = append(points, &p)
+	}
+
+	return points, nil
+}
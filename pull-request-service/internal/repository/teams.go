@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"pull-request-service/internal/models"
 	database "pull-request-service/pkg/db"
@@ -18,21 +19,26 @@ func NewTeamsRepository(db *pgxpool.Pool) *TeamsRepository {
 	return &TeamsRepository{db: db}
 }
 
-func (repo *TeamsRepository) InsertTeam(ctx context.Context, teamName string) error {
+// InsertTeam creates the team or, if it already exists, updates its parent when one is given.
+// It reports whether the team was newly created.
+func (repo *TeamsRepository) InsertTeam(ctx context.Context, teamName, parentTeamName string) (bool, error) {
 	tx := database.GetTx(ctx, repo.db)
 
 	query := `
-		INSERT INTO teams (team_name) 
-		VALUES ($1) 
-		ON CONFLICT (team_name) DO NOTHING
+		INSERT INTO teams (team_name, parent_team_name) 
+		VALUES ($1, NULLIF($2, '')) 
+		ON CONFLICT (team_name) DO UPDATE
+		SET parent_team_name = COALESCE(EXCLUDED.parent_team_name, teams.parent_team_name)
+		RETURNING (xmax = 0)
 	`
 
-	_, err := tx.Exec(ctx, query, teamName)
+	var created bool
+	err := tx.QueryRow(ctx, query, teamName, parentTeamName).Scan(&created)
 	if err != nil {
-		return fmt.Errorf("inserting team: %w", err)
+		return false, fmt.Errorf("inserting team: %w", err)
 	}
 
-	return nil
+	return created, nil
 }
 
 func (repo *TeamsRepository) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
@@ -40,21 +46,23 @@ func (repo *TeamsRepository) GetTeam(ctx context.Context, teamName string) (*mod
 	tx := database.GetTx(ctx, repo.db)
 
 	teamQuery := `
-		SELECT team_name
+		SELECT team_name, COALESCE(parent_team_name, '')
 		FROM teams
 		WHERE team_name=$1
 	`
 
-	err := tx.QueryRow(ctx, teamQuery, teamName).Scan(&t.TeamName)
+	err := tx.QueryRow(ctx, teamQuery, teamName).Scan(&t.TeamName, &t.ParentTeamName)
 	if err != nil {
 		return nil, fmt.Errorf("selecting team: %w", err)
 	}
 
 
 	membersQuery := `
-		SELECT user_id, username, is_active 
-		FROM users 
-		WHERE team_name=$1
+		SELECT u.user_id, u.username, u.is_active, m.is_primary, m.review_weight 
+		FROM team_memberships m
+		INNER JOIN users u ON u.user_id = m.user_id
+		WHERE m.team_name=$1
+		ORDER BY u.user_id
 	`
 
 	rows, err := tx.Query(ctx, membersQuery, teamName)
@@ -66,7 +74,7 @@ func (repo *TeamsRepository) GetTeam(ctx context.Context, teamName string) (*mod
 	for rows.Next() {
 		var u models.TeamMember
 
-		err := rows.Scan(&u.UserID, &u.Username, &u.IsActive)
+		err := rows.Scan(&u.UserID, &u.Username, &u.IsActive, &u.IsPrimary, &u.ReviewWeight)
 		if err != nil {
 			return nil, fmt.Errorf("scanning team member: %w", err)
 		}
@@ -95,9 +103,11 @@ func (repo *TeamsRepository) GetUserTeam(ctx context.Context, userID string) (st
 
 func (repo *TeamsRepository) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]string, error) {
 	query := `
-		SELECT user_id 
-		FROM users 
-		WHERE team_name=$1 AND is_active=true AND user_id<>$2
+		SELECT DISTINCT u.user_id 
+		FROM team_memberships m
+		INNER JOIN users u ON u.user_id = m.user_id
+		WHERE m.team_name=$1 AND u.is_active=true AND u.user_id<>$2
+		ORDER BY u.user_id
 	`
 
 	tx := database.GetTx(ctx, repo.db)
@@ -118,3 +128,350 @@ func (repo *TeamsRepository) GetActiveTeamMembers(ctx context.Context, teamName
 
 	return members, nil
 }
+
+// GetUserTeams returns all teams of the user, the primary one first.
+func (repo *TeamsRepository) GetUserTeams(ctx context.Context, userID string) ([]string, error) {
+	query := `
+		SELECT team_name 
+		FROM team_memberships 
+		WHERE user_id=$1
+		ORDER BY is_primary DESC, team_name
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	rows, err := tx.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("querying user teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scanning user team: %w", err)
+		}
+		teams = append(teams, name)
+	}
+
+	return teams, nil
+}
+
+// GetActiveTeamCandidates returns active members of the team with their review weight in it.
+// Members with a zero weight are left out.
+func (repo *TeamsRepository) GetActiveTeamCandidates(ctx context.Context, teamName string, excludeUserID string) ([]*models.ReviewCandidate, error) {
+	query := `
+		SELECT u.user_id, m.review_weight 
+		FROM team_memberships m
+		INNER JOIN users u ON u.user_id = m.user_id
+		WHERE m.team_name=$1 AND m.review_weight > 0 AND u.is_active=true AND u.user_id<>$2
+		ORDER BY u.user_id
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	rows, err := tx.Query(ctx, query, teamName, excludeUserID)
+	if err != nil {
+		return nil, fmt.Errorf("querying team candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*models.ReviewCandidate
+	for rows.Next() {
+		var c models.ReviewCandidate
+		if err := rows.Scan(&c.UserID, &c.Weight); err != nil {
+			return nil, fmt.Errorf("scanning team candidate: %w", err)
+		}
+		candidates = append(candidates, &c)
+	}
+
+	return candidates, nil
+}
+
+func (repo *TeamsRepository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM teams 
+			WHERE team_name=$1
+		)
+	`
+
+	var exists bool
+	tx := database.GetTx(ctx, repo.db)
+	err := tx.QueryRow(ctx, query, teamName).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking team existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// RenameTeam renames a team together with its membership history, so past stats follow the new name.
+func (repo *TeamsRepository) RenameTeam(ctx context.Context, teamName, newTeamName string) error {
+	query := `
+		WITH renamed AS (
+			UPDATE teams 
+			SET team_name=$2 
+			WHERE team_name=$1
+		), snapshots AS (
+			UPDATE review_load_snapshots 
+			SET team_name=$2 
+			WHERE team_name=$1
+		)
+		UPDATE team_membership_history 
+		SET team_name=$2 
+		WHERE team_name=$1
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, teamName, newTeamName)
+	if err != nil {
+		return fmt.Errorf("renaming team: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *TeamsRepository) DeleteTeam(ctx context.Context, teamName string) error {
+	query := `
+		DELETE FROM teams 
+		WHERE team_name=$1
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, teamName)
+	if err != nil {
+		return fmt.Errorf("deleting team: %w", err)
+	}
+
+	return nil
+}
+
+// CountMembers counts users that belong to the team as primary or secondary members.
+func (repo *TeamsRepository) CountMembers(ctx context.Context, teamName string) (int, error) {
+	query := `
+		SELECT COUNT(*) 
+		FROM (
+			SELECT user_id FROM users WHERE team_name=$1
+			UNION
+			SELECT user_id FROM team_memberships WHERE team_name=$1
+		) m
+	`
+
+	var count int
+	tx := database.GetTx(ctx, repo.db)
+	err := tx.QueryRow(ctx, query, teamName).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("counting team members: %w", err)
+	}
+
+	return count, nil
+}
+
+// MoveMembers moves all primary members of a team to newTeamName and closes their membership intervals.
+func (repo *TeamsRepository) MoveMembers(ctx context.Context, teamName, newTeamName string) error {
+	query := `
+		WITH moved AS (
+			UPDATE users 
+			SET team_name=$2 
+			WHERE team_name=$1
+			RETURNING user_id
+		), closed AS (
+			UPDATE team_membership_history 
+			SET valid_to=$3 
+			WHERE valid_to IS NULL AND user_id IN (SELECT user_id FROM moved)
+		), untracked AS (
+			INSERT INTO team_membership_history (user_id, team_name, valid_from, valid_to)
+			SELECT m.user_id, $1, NULL, $3
+			FROM moved m
+			WHERE NOT EXISTS (
+				SELECT 1 FROM team_membership_history h 
+				WHERE h.user_id = m.user_id AND h.valid_to IS NULL
+			)
+		)
+		INSERT INTO team_membership_history (user_id, team_name, valid_from)
+		SELECT user_id, $2, $3
+		FROM moved
+	`
+
+	now := time.Now().UTC()
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, teamName, newTeamName, now)
+	if err != nil {
+		return fmt.Errorf("moving team members: %w", err)
+	}
+
+	dropQuery := `
+		DELETE FROM team_memberships 
+		WHERE team_name=$1 AND is_primary
+	`
+
+	if _, err := tx.Exec(ctx, dropQuery, teamName); err != nil {
+		return fmt.Errorf("dropping moved memberships: %w", err)
+	}
+
+	primaryQuery := `
+		INSERT INTO team_memberships (user_id, team_name, is_primary)
+		SELECT user_id, team_name, true
+		FROM users
+		WHERE team_name=$1
+		ON CONFLICT (user_id, team_name) DO UPDATE
+		SET is_primary = true
+	`
+
+	if _, err := tx.Exec(ctx, primaryQuery, newTeamName); err != nil {
+		return fmt.Errorf("setting moved memberships: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *TeamsRepository) GetParentTeam(ctx context.Context, teamName string) (string, error) {
+	query := `
+		SELECT COALESCE(parent_team_name, '') 
+		FROM teams 
+		WHERE team_name=$1
+	`
+
+	var parent string
+	tx := database.GetTx(ctx, repo.db)
+	err := tx.QueryRow(ctx, query, teamName).Scan(&parent)
+	if err != nil {
+		return "", fmt.Errorf("getting parent team: %w", err)
+	}
+
+	return parent, nil
+}
+
+func (repo *TeamsRepository) GetChildTeams(ctx context.Context, teamName string) ([]string, error) {
+	query := `
+		SELECT team_name 
+		FROM teams 
+		WHERE parent_team_name=$1
+		ORDER BY team_name
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	rows, err := tx.Query(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("querying child teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scanning child team: %w", err)
+		}
+		teams = append(teams, name)
+	}
+
+	return teams, nil
+}
+
+func (repo *TeamsRepository) SetParentTeam(ctx context.Context, teamName, parentTeamName string) error {
+	query := `
+		UPDATE teams 
+		SET parent_team_name=NULLIF($2, '') 
+		WHERE team_name=$1
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	_, err := tx.Exec(ctx, query, teamName, parentTeamName)
+	if err != nil {
+		return fmt.Errorf("setting parent team: %w", err)
+	}
+
+	return nil
+}
+
+// GetTeamReviewsStats returns per-team review counts, where the totals of each team include all of its descendants.
+// Unprefixed counts cover the team itself only.
+func (repo *TeamsRepository) GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error) {
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT team_name AS root, team_name
+			FROM teams
+			UNION ALL
+			SELECT tree.root, t.team_name
+			FROM teams t
+			INNER JOIN tree ON t.parent_team_name = tree.team_name
+		), own AS (
+			SELECT 
+				COALESCE(mh.team_name, u.team_name) AS team_name,
+				COUNT(*) AS reviews,
+				COUNT(*) FILTER (WHERE pr.status='OPEN') AS open_reviews
+			FROM pr_reviewers prr
+			INNER JOIN users u ON u.user_id = prr.user_id
+			INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			LEFT JOIN LATERAL (
+				SELECT h.team_name
+				FROM team_membership_history h
+				WHERE h.user_id = prr.user_id
+					AND (h.valid_from IS NULL OR h.valid_from <= prr.assigned_at)
+					AND (h.valid_to IS NULL OR h.valid_to > prr.assigned_at)
+				ORDER BY h.valid_from DESC NULLS LAST
+				LIMIT 1
+			) mh ON true
+			GROUP BY COALESCE(mh.team_name, u.team_name)
+		)
+		SELECT 
+			tree.root,
+			COALESCE(t.parent_team_name, ''),
+			COALESCE(SUM(own.reviews) FILTER (WHERE tree.team_name = tree.root), 0),
+			COALESCE(SUM(own.reviews), 0),
+			COALESCE(SUM(own.open_reviews) FILTER (WHERE tree.team_name = tree.root), 0),
+			COALESCE(SUM(own.open_reviews), 0)
+		FROM tree
+		INNER JOIN teams t ON t.team_name = tree.root
+		LEFT JOIN own ON own.team_name = tree.team_name
+		GROUP BY tree.root, t.parent_team_name
+		ORDER BY tree.root
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("querying team reviews stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*models.TeamReviewStats
+	for rows.Next() {
+		var ts models.TeamReviewStats
+		err := rows.Scan(&ts.TeamName, &ts.ParentTeamName, &ts.ReviewsNumber, &ts.TotalReviewsNumber, &ts.OpenReviewsNumber, &ts.TotalOpenReviewsNumber)
+		if err != nil {
+			return nil, fmt.Errorf("scanning team reviews stats: %w", err)
+		}
+		stats = append(stats, &ts)
+	}
+
+	return stats, nil
+}
+
+func (repo *TeamsRepository) ListTeams(ctx context.Context) ([]*models.Team, error) {
+	query := `
+		SELECT team_name, COALESCE(parent_team_name, '') 
+		FROM teams 
+		ORDER BY team_name
+	`
+
+	tx := database.GetTx(ctx, repo.db)
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("querying teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []*models.Team
+	for rows.Next() {
+		var t models.Team
+		if err := rows.Scan(&t.TeamName, &t.ParentTeamName); err != nil {
+			return nil, fmt.Errorf("scanning team: %w", err)
+		}
+		teams = append(teams, &t)
+	}
+
+	return teams, nil
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"pull-request-service/internal/models"
 	database "pull-request-service/pkg/db"
@@ -19,16 +21,17 @@ func NewUsersRepository(db *pgxpool.Pool) *UsersRepository {
 }
 
 
-func (repo *UsersRepository) UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool) error {
+// UpdateUserActiveStatus sets the active flag and replaces any pending reactivation with until.
+func (repo *UsersRepository) UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) error {
 	tx := database.GetTx(ctx, repo.db)
 
 	query := `
 		UPDATE users 
-		SET is_active=$1 
+		SET is_active=$1, inactive_until=$3 
 		WHERE user_id=$2
 	`
 
-	_, err := tx.Exec(ctx, query, isActive, userID)
+	_, err := tx.Exec(ctx, query, isActive, userID, until)
 	if err != nil {
 		return fmt.Errorf("updating user status: %w", err)
 	}
@@ -38,38 +41,442 @@ func (repo *UsersRepository) UpdateUserActiveStatus(ctx context.Context, userID
 
 func (repo *UsersRepository) GetUser(ctx context.Context, userID string) (*models.User, error) {
 	var u models.User
+	var p models.UserProfile
 	tx := database.GetTx(ctx, repo.db)
 
 	query := `
-		SELECT user_id, username, team_name, is_active 
+		SELECT 
+			user_id, username, COALESCE(team_name, ''), is_active, role, offboarded_at, inactive_until,
+			COALESCE(email, ''), COALESCE(chat_handle, ''), COALESCE(preferred_language, ''),
+			notify_assigned, notify_reassigned, notify_overdue, notify_merged
 		FROM users 
 		WHERE user_id=$1
 	`
 
-	err := tx.QueryRow(ctx, query, userID).Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive)
+	err := tx.QueryRow(ctx, query, userID).Scan(
+		&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.Role, &u.OffboardedAt, &u.InactiveUntil,
+		&p.Email, &p.ChatHandle, &p.PreferredLanguage,
+		&p.Notifications.Assigned, &p.Notifications.Reassigned, &p.Notifications.Overdue, &p.Notifications.Merged,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("getting user: %w", err)
 	}
+	u.Profile = &p
 
 	return &u, nil
 }
 
+// UpsertUser inserts or updates a user and records a membership interval when the team changes.
+// Offboarded users are never updated; USER_OFFBOARDED is returned instead.
 func (repo *UsersRepository) UpsertUser(ctx context.Context, userID, username, teamName string, isActive bool) error {
 	tx := database.GetTx(ctx, repo.db)
 
 	query := `
-		INSERT INTO users (user_id, username, team_name, is_active) 
+		WITH prev AS (
+			SELECT COALESCE(team_name, '') AS team_name, offboarded_at IS NOT NULL AS offboarded 
+			FROM users 
+			WHERE user_id=$1
+		), upserted AS (
+			INSERT INTO users (user_id, username, team_name, is_active) 
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id) DO UPDATE
+			SET 
+				username  = EXCLUDED.username,
+				team_name = EXCLUDED.team_name,
+				is_active = EXCLUDED.is_active,
+				inactive_until = NULL
+			WHERE users.offboarded_at IS NULL
+		)
+		SELECT 
+			EXISTS (SELECT 1 FROM prev), 
+			COALESCE((SELECT team_name FROM prev), ''), 
+			COALESCE((SELECT offboarded FROM prev), false)
+	`
+
+	var existed, offboarded bool
+	var prevTeam string
+	err := tx.QueryRow(ctx, query, userID, username, teamName, isActive).Scan(&existed, &prevTeam, &offboarded)
+	if err != nil {
+		return fmt.Errorf("upserting user: %w", err)
+	}
+
+	if offboarded {
+		return fmt.Errorf("upserting user: %s", models.ErrUserOffboarded)
+	}
+
+	if existed && prevTeam == teamName {
+		return nil
+	}
+
+	if err := setPrimaryMembership(ctx, tx, userID, teamName); err != nil {
+		return err
+	}
+
+	return repo.RecordTeamChange(ctx, userID, prevTeam, teamName)
+}
+
+func (repo *UsersRepository) UpdateUserTeam(ctx context.Context, userID, teamName string) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
+		SET team_name=$1 
+		WHERE user_id=$2
+	`
+
+	_, err := tx.Exec(ctx, query, teamName, userID)
+	if err != nil {
+		return fmt.Errorf("updating user team: %w", err)
+	}
+
+	return setPrimaryMembership(ctx, tx, userID, teamName)
+}
+
+// RecordTeamChange closes the user's open membership interval and opens a new one for toTeam, if any.
+// If the user has no tracked membership yet, fromTeam is recorded as an interval with an unknown start.
+func (repo *UsersRepository) RecordTeamChange(ctx context.Context, userID, fromTeam, toTeam string) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		WITH closed AS (
+			UPDATE team_membership_history 
+			SET valid_to=$4 
+			WHERE user_id=$1 AND valid_to IS NULL
+			RETURNING id
+		), untracked AS (
+			INSERT INTO team_membership_history (user_id, team_name, valid_from, valid_to)
+			SELECT $1, $2::text, NULL, $4
+			WHERE $2::text <> '' AND NOT EXISTS (SELECT 1 FROM closed)
+		)
+		INSERT INTO team_membership_history (user_id, team_name, valid_from) 
+		SELECT $1, $3::text, $4
+		WHERE $3::text <> ''
+	`
+
+	now := time.Now().UTC()
+	_, err := tx.Exec(ctx, query, userID, fromTeam, toTeam, now)
+	if err != nil {
+		return fmt.Errorf("recording team change: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *UsersRepository) ListUsers(ctx context.Context) ([]*models.User, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT user_id, username, COALESCE(team_name, ''), is_active, offboarded_at 
+		FROM users 
+		ORDER BY user_id
+	`
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("querying users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.OffboardedAt); err != nil {
+			return nil, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, &u)
+	}
+
+	return users, nil
+}
+
+// DetachUser removes the user from their team and deactivates them.
+func (repo *UsersRepository) DetachUser(ctx context.Context, userID string) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		WITH prev AS (
+			SELECT COALESCE(team_name, '') AS team_name 
+			FROM users 
+			WHERE user_id=$1
+		), detached AS (
+			UPDATE users 
+			SET team_name=NULL, is_active=false, inactive_until=NULL 
+			WHERE user_id=$1
+		)
+		SELECT team_name FROM prev
+	`
+
+	var prevTeam string
+	err := tx.QueryRow(ctx, query, userID).Scan(&prevTeam)
+	if err != nil {
+		return fmt.Errorf("detaching user: %w", err)
+	}
+
+	if err := setPrimaryMembership(ctx, tx, userID, ""); err != nil {
+		return err
+	}
+
+	return repo.RecordTeamChange(ctx, userID, prevTeam, "")
+}
+
+func (repo *UsersRepository) GetUserMemberships(ctx context.Context, userID string) ([]models.TeamMembership, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT team_name, is_primary, review_weight 
+		FROM team_memberships 
+		WHERE user_id=$1
+		ORDER BY is_primary DESC, team_name
+	`
+
+	rows, err := tx.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("querying user memberships: %w", err)
+	}
+	defer rows.Close()
+
+	memberships := []models.TeamMembership{}
+	for rows.Next() {
+		var m models.TeamMembership
+		if err := rows.Scan(&m.TeamName, &m.IsPrimary, &m.ReviewWeight); err != nil {
+			return nil, fmt.Errorf("scanning user membership: %w", err)
+		}
+		memberships = append(memberships, m)
+	}
+
+	return memberships, nil
+}
+
+// ReplaceMemberships replaces all memberships of the user and points users.team_name at the primary one.
+func (repo *UsersRepository) ReplaceMemberships(ctx context.Context, userID string, memberships []models.TeamMembership) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	deleteQuery := `
+		DELETE FROM team_memberships 
+		WHERE user_id=$1
+	`
+
+	if _, err := tx.Exec(ctx, deleteQuery, userID); err != nil {
+		return fmt.Errorf("deleting user memberships: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO team_memberships (user_id, team_name, is_primary, review_weight) 
 		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id) DO UPDATE
+	`
+
+	for _, m := range memberships {
+		if _, err := tx.Exec(ctx, insertQuery, userID, m.TeamName, m.IsPrimary, m.ReviewWeight); err != nil {
+			return fmt.Errorf("inserting user membership: %w", err)
+		}
+
+		if !m.IsPrimary {
+			continue
+		}
+
+		updateQuery := `
+			UPDATE users 
+			SET team_name=$1 
+			WHERE user_id=$2
+		`
+
+		if _, err := tx.Exec(ctx, updateQuery, m.TeamName, userID); err != nil {
+			return fmt.Errorf("updating primary team: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (repo *UsersRepository) GetUsers(ctx context.Context, userIDs []string) ([]*models.User, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT user_id, username, COALESCE(team_name, ''), is_active 
+		FROM users 
+		WHERE user_id = ANY($1)
+		ORDER BY user_id
+	`
+
+	rows, err := tx.Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("querying users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			return nil, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, &u)
+	}
+
+	return users, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchUsers returns up to limit users ordered by user_id, starting after the cursor.
+func (repo *UsersRepository) SearchUsers(ctx context.Context, q *models.ListUsersQuery, limit int) ([]*models.User, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		SELECT user_id, username, COALESCE(team_name, ''), is_active, role, offboarded_at 
+		FROM users u
+		WHERE u.user_id > $1
+	`
+	args := []any{q.Cursor}
+
+	if q.TeamName != "" {
+		args = append(args, q.TeamName)
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM team_memberships tm WHERE tm.user_id = u.user_id AND tm.team_name = $%d)`, len(args))
+	}
+	if q.IsActive != nil {
+		args = append(args, *q.IsActive)
+		query += fmt.Sprintf(` AND u.is_active = $%d`, len(args))
+	}
+	if q.Role != "" {
+		args = append(args, q.Role)
+		query += fmt.Sprintf(` AND u.role = $%d`, len(args))
+	}
+	if q.Search != "" {
+		args = append(args, likeEscaper.Replace(q.Search)+"%", q.Search)
+		query += fmt.Sprintf(` AND (u.username ILIKE $%d OR u.username %% $%d)`, len(args)-1, len(args))
+	}
+
+	args = append(args, limit)
+	query += fmt.Sprintf(` ORDER BY u.user_id LIMIT $%d`, len(args))
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*models.User, 0, limit)
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.IsActive, &u.Role, &u.OffboardedAt); err != nil {
+			return nil, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, &u)
+	}
+
+	return users, nil
+}
+
+func (repo *UsersRepository) UpdateUserRole(ctx context.Context, userID string, role models.UserRole) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
+		SET role=$1 
+		WHERE user_id=$2
+	`
+
+	_, err := tx.Exec(ctx, query, role, userID)
+	if err != nil {
+		return fmt.Errorf("updating user role: %w", err)
+	}
+
+	return nil
+}
+
+// OffboardUser replaces the username with a pseudonym, clears contact details, deactivates the user
+// and drops all team memberships.
+// Review rows are kept, so statistics still count them under the pseudonym.
+func (repo *UsersRepository) OffboardUser(ctx context.Context, userID, pseudonym string) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
 		SET 
-			username  = EXCLUDED.username,
-			team_name = EXCLUDED.team_name,
-			is_active = EXCLUDED.is_active
+			username=$2, team_name=NULL, is_active=false, role='member', offboarded_at=$3, inactive_until=NULL,
+			email=NULL, chat_handle=NULL, preferred_language=NULL
+		WHERE user_id=$1
 	`
 
-	_, err := tx.Exec(ctx, query, userID, username, teamName, isActive)
+	_, err := tx.Exec(ctx, query, userID, pseudonym, time.Now().UTC())
 	if err != nil {
-		return fmt.Errorf("upserting user: %w", err)
+		return fmt.Errorf("offboarding user: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM team_memberships WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("deleting memberships: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *UsersRepository) UpdateUserProfile(ctx context.Context, userID string, p *models.UserProfile) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
+		SET 
+			email=NULLIF($2, ''),
+			chat_handle=NULLIF($3, ''),
+			preferred_language=NULLIF($4, ''),
+			notify_assigned=$5,
+			notify_reassigned=$6,
+			notify_overdue=$7,
+			notify_merged=$8
+		WHERE user_id=$1
+	`
+
+	_, err := tx.Exec(ctx, query, userID, p.Email, p.ChatHandle, p.PreferredLanguage,
+		p.Notifications.Assigned, p.Notifications.Reassigned, p.Notifications.Overdue, p.Notifications.Merged)
+	if err != nil {
+		return fmt.Errorf("updating user profile: %w", err)
+	}
+
+	return nil
+}
+
+// ReactivateExpired activates users whose temporary deactivation ended by now and returns their ids.
+func (repo *UsersRepository) ReactivateExpired(ctx context.Context, now time.Time) ([]string, error) {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
+		SET is_active=true, inactive_until=NULL 
+		WHERE inactive_until <= $1 AND offboarded_at IS NULL
+		RETURNING user_id
+	`
+
+	rows, err := tx.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("reactivating users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("scanning reactivated user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
+
+func (repo *UsersRepository) CancelReactivation(ctx context.Context, userID string) error {
+	tx := database.GetTx(ctx, repo.db)
+
+	query := `
+		UPDATE users 
+		SET inactive_until=NULL 
+		WHERE user_id=$1
+	`
+
+	_, err := tx.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("cancelling reactivation: %w", err)
 	}
 
 	return nil
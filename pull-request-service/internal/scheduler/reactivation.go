@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Reactivator interface {
+	ReactivateExpired(ctx context.Context) ([]string, error)
+}
+
+// RunReactivation reactivates users whose temporary deactivation has expired every interval until ctx is done.
+func RunReactivation(ctx context.Context, r Reactivator, interval time.Duration, logger *slog.Logger) {
+	runEvery(ctx, interval, func() {
+		reactivate(ctx, r, logger)
+	})
+}
+
+func reactivate(ctx context.Context, r Reactivator, logger *slog.Logger) {
+	userIDs, err := r.ReactivateExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error("reactivate expired users failed", "err", err)
+		}
+		return
+	}
+
+	if len(userIDs) > 0 {
+		logger.Info("users reactivated", "user_ids", userIDs)
+	}
+}
@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Snapshotter interface {
+	TakeLoadSnapshot(ctx context.Context) (int, error)
+}
+
+// RunSnapshots refreshes today's review load snapshot every interval until ctx is done,
+// so each day keeps the last state seen before midnight UTC.
+func RunSnapshots(ctx context.Context, s Snapshotter, interval time.Duration, logger *slog.Logger) {
+	runEvery(ctx, interval, func() {
+		n, err := s.TakeLoadSnapshot(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("take review load snapshot failed", "err", err)
+			}
+			return
+		}
+
+		logger.Debug("review load snapshot taken", "users", n)
+	})
+}
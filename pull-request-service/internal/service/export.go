@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pull-request-service/internal/models"
+)
+
+type ExportRepository interface {
+	StreamReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error
+	StreamPullRequests(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error) error
+	StreamRoster(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error) error
+}
+
+// ExportService hands rows to the caller one at a time; an error returned by fn stops the export.
+type ExportService struct {
+	exportRepo ExportRepository
+}
+
+func NewExportService(r ExportRepository) *ExportService {
+	return &ExportService{
+		exportRepo: r,
+	}
+}
+
+func (s *ExportService) ExportReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error {
+	if err := s.exportRepo.StreamReviewsStats(ctx, q, fn); err != nil {
+		return fmt.Errorf("exporting reviews stats: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ExportService) ExportPullRequests(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error) error {
+	if err := s.exportRepo.StreamPullRequests(ctx, q, fn); err != nil {
+		return fmt.Errorf("exporting PRs: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ExportService) ExportRoster(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error) error {
+	if err := s.exportRepo.StreamRoster(ctx, q, fn); err != nil {
+		return fmt.Errorf("exporting roster: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pull-request-service/internal/models"
+	"pull-request-service/internal/service"
+	"pull-request-service/internal/service/mocks"
+)
+
+func TestExportService_ExportReviewsStats(t *testing.T) {
+	ctx := context.Background()
+	rows := []*models.ReviewerStats{
+		{UserID: "u1", Username: "Alice", ReviewsNumber: 2},
+		{UserID: "u2", Username: "Bob", ReviewsNumber: 1},
+	}
+	errStop := errors.New("client gone")
+
+	tests := []struct {
+		name     string
+		repoErr  error
+		stopAt   int
+		wantRows int
+		wantErr  bool
+	}{
+		{name: "streams every row", wantRows: 2},
+		{name: "callback error stops the export", stopAt: 1, wantRows: 1, wantErr: true},
+		{name: "repo error", repoErr: errors.New("fail"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewExportRepository(t)
+			q := &models.ReviewsStatsQuery{TeamName: "backend"}
+			repo.EXPECT().StreamReviewsStats(mock.Anything, q, mock.Anything).
+				RunAndReturn(func(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error {
+					if tt.repoErr != nil {
+						return tt.repoErr
+					}
+					for _, rs := range rows {
+						if err := fn(rs); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+
+			svc := service.NewExportService(repo)
+
+			var got []string
+			err := svc.ExportReviewsStats(ctx, q, func(rs *models.ReviewerStats) error {
+				got = append(got, rs.UserID)
+				if len(got) == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.stopAt > 0 {
+					assert.ErrorIs(t, err, errStop)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, got, tt.wantRows)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExportRepository is an autogenerated mock type for the ExportRepository type
+type ExportRepository struct {
+	mock.Mock
+}
+
+type ExportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExportRepository) EXPECT() *ExportRepository_Expecter {
+	return &ExportRepository_Expecter{mock: &_m.Mock}
+}
+
+// StreamPullRequests provides a mock function with given fields: ctx, q, fn
+func (_m *ExportRepository) StreamPullRequests(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error) error {
+	ret := _m.Called(ctx, q, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPullRequests")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PullRequestExportQuery, func(*models.PullRequest) error) error); ok {
+		r0 = rf(ctx, q, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportRepository_StreamPullRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPullRequests'
+type ExportRepository_StreamPullRequests_Call struct {
+	*mock.Call
+}
+
+// StreamPullRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.PullRequestExportQuery
+//   - fn func(*models.PullRequest) error
+func (_e *ExportRepository_Expecter) StreamPullRequests(ctx interface{}, q interface{}, fn interface{}) *ExportRepository_StreamPullRequests_Call {
+	return &ExportRepository_StreamPullRequests_Call{Call: _e.mock.On("StreamPullRequests", ctx, q, fn)}
+}
+
+func (_c *ExportRepository_StreamPullRequests_Call) Run(run func(ctx context.Context, q *models.PullRequestExportQuery, fn func(*models.PullRequest) error)) *ExportRepository_StreamPullRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PullRequestExportQuery), args[2].(func(*models.PullRequest) error))
+	})
+	return _c
+}
+
+func (_c *ExportRepository_StreamPullRequests_Call) Return(_a0 error) *ExportRepository_StreamPullRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExportRepository_StreamPullRequests_Call) RunAndReturn(run func(context.Context, *models.PullRequestExportQuery, func(*models.PullRequest) error) error) *ExportRepository_StreamPullRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamReviewsStats provides a mock function with given fields: ctx, q, fn
+func (_m *ExportRepository) StreamReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error) error {
+	ret := _m.Called(ctx, q, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamReviewsStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReviewsStatsQuery, func(*models.ReviewerStats) error) error); ok {
+		r0 = rf(ctx, q, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportRepository_StreamReviewsStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamReviewsStats'
+type ExportRepository_StreamReviewsStats_Call struct {
+	*mock.Call
+}
+
+// StreamReviewsStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.ReviewsStatsQuery
+//   - fn func(*models.ReviewerStats) error
+func (_e *ExportRepository_Expecter) StreamReviewsStats(ctx interface{}, q interface{}, fn interface{}) *ExportRepository_StreamReviewsStats_Call {
+	return &ExportRepository_StreamReviewsStats_Call{Call: _e.mock.On("StreamReviewsStats", ctx, q, fn)}
+}
+
+func (_c *ExportRepository_StreamReviewsStats_Call) Run(run func(ctx context.Context, q *models.ReviewsStatsQuery, fn func(*models.ReviewerStats) error)) *ExportRepository_StreamReviewsStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ReviewsStatsQuery), args[2].(func(*models.ReviewerStats) error))
+	})
+	return _c
+}
+
+func (_c *ExportRepository_StreamReviewsStats_Call) Return(_a0 error) *ExportRepository_StreamReviewsStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExportRepository_StreamReviewsStats_Call) RunAndReturn(run func(context.Context, *models.ReviewsStatsQuery, func(*models.ReviewerStats) error) error) *ExportRepository_StreamReviewsStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamRoster provides a mock function with given fields: ctx, q, fn
+func (_m *ExportRepository) StreamRoster(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error) error {
+	ret := _m.Called(ctx, q, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamRoster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.RosterExportQuery, func(*models.RosterEntry) error) error); ok {
+		r0 = rf(ctx, q, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportRepository_StreamRoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamRoster'
+type ExportRepository_StreamRoster_Call struct {
+	*mock.Call
+}
+
+// StreamRoster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.RosterExportQuery
+//   - fn func(*models.RosterEntry) error
+func (_e *ExportRepository_Expecter) StreamRoster(ctx interface{}, q interface{}, fn interface{}) *ExportRepository_StreamRoster_Call {
+	return &ExportRepository_StreamRoster_Call{Call: _e.mock.On("StreamRoster", ctx, q, fn)}
+}
+
+func (_c *ExportRepository_StreamRoster_Call) Run(run func(ctx context.Context, q *models.RosterExportQuery, fn func(*models.RosterEntry) error)) *ExportRepository_StreamRoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.RosterExportQuery), args[2].(func(*models.RosterEntry) error))
+	})
+	return _c
+}
+
+func (_c *ExportRepository_StreamRoster_Call) Return(_a0 error) *ExportRepository_StreamRoster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExportRepository_StreamRoster_Call) RunAndReturn(run func(context.Context, *models.RosterExportQuery, func(*models.RosterEntry) error) error) *ExportRepository_StreamRoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExportRepository creates a new instance of ExportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExportRepository {
+	mock := &ExportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
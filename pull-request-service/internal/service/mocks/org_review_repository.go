@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrgReviewRepository is an autogenerated mock type for the OrgReviewRepository type
+type OrgReviewRepository struct {
+	mock.Mock
+}
+
+type OrgReviewRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrgReviewRepository) EXPECT() *OrgReviewRepository_Expecter {
+	return &OrgReviewRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPRsByReviewer provides a mock function with given fields: ctx, userID
+func (_m *OrgReviewRepository) GetPRsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPRsByReviewer")
+	}
+
+	var r0 []*models.PullRequestShort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.PullRequestShort, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.PullRequestShort); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.PullRequestShort)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgReviewRepository_GetPRsByReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPRsByReviewer'
+type OrgReviewRepository_GetPRsByReviewer_Call struct {
+	*mock.Call
+}
+
+// GetPRsByReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *OrgReviewRepository_Expecter) GetPRsByReviewer(ctx interface{}, userID interface{}) *OrgReviewRepository_GetPRsByReviewer_Call {
+	return &OrgReviewRepository_GetPRsByReviewer_Call{Call: _e.mock.On("GetPRsByReviewer", ctx, userID)}
+}
+
+func (_c *OrgReviewRepository_GetPRsByReviewer_Call) Run(run func(ctx context.Context, userID string)) *OrgReviewRepository_GetPRsByReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrgReviewRepository_GetPRsByReviewer_Call) Return(_a0 []*models.PullRequestShort, _a1 error) *OrgReviewRepository_GetPRsByReviewer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrgReviewRepository_GetPRsByReviewer_Call) RunAndReturn(run func(context.Context, string) ([]*models.PullRequestShort, error)) *OrgReviewRepository_GetPRsByReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrgReviewRepository creates a new instance of OrgReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrgReviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrgReviewRepository {
+	mock := &OrgReviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
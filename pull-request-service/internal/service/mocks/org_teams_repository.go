@@ -0,0 +1,201 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrgTeamsRepository is an autogenerated mock type for the OrgTeamsRepository type
+type OrgTeamsRepository struct {
+	mock.Mock
+}
+
+type OrgTeamsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrgTeamsRepository) EXPECT() *OrgTeamsRepository_Expecter {
+	return &OrgTeamsRepository_Expecter{mock: &_m.Mock}
+}
+
+// InsertTeam provides a mock function with given fields: ctx, teamName, parentTeamName
+func (_m *OrgTeamsRepository) InsertTeam(ctx context.Context, teamName string, parentTeamName string) (bool, error) {
+	ret := _m.Called(ctx, teamName, parentTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertTeam")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, teamName, parentTeamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, teamName, parentTeamName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, teamName, parentTeamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgTeamsRepository_InsertTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertTeam'
+type OrgTeamsRepository_InsertTeam_Call struct {
+	*mock.Call
+}
+
+// InsertTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - parentTeamName string
+func (_e *OrgTeamsRepository_Expecter) InsertTeam(ctx interface{}, teamName interface{}, parentTeamName interface{}) *OrgTeamsRepository_InsertTeam_Call {
+	return &OrgTeamsRepository_InsertTeam_Call{Call: _e.mock.On("InsertTeam", ctx, teamName, parentTeamName)}
+}
+
+func (_c *OrgTeamsRepository_InsertTeam_Call) Run(run func(ctx context.Context, teamName string, parentTeamName string)) *OrgTeamsRepository_InsertTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrgTeamsRepository_InsertTeam_Call) Return(_a0 bool, _a1 error) *OrgTeamsRepository_InsertTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrgTeamsRepository_InsertTeam_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *OrgTeamsRepository_InsertTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeams provides a mock function with given fields: ctx
+func (_m *OrgTeamsRepository) ListTeams(ctx context.Context) ([]*models.Team, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 []*models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.Team, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Team); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgTeamsRepository_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
+type OrgTeamsRepository_ListTeams_Call struct {
+	*mock.Call
+}
+
+// ListTeams is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrgTeamsRepository_Expecter) ListTeams(ctx interface{}) *OrgTeamsRepository_ListTeams_Call {
+	return &OrgTeamsRepository_ListTeams_Call{Call: _e.mock.On("ListTeams", ctx)}
+}
+
+func (_c *OrgTeamsRepository_ListTeams_Call) Run(run func(ctx context.Context)) *OrgTeamsRepository_ListTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrgTeamsRepository_ListTeams_Call) Return(_a0 []*models.Team, _a1 error) *OrgTeamsRepository_ListTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrgTeamsRepository_ListTeams_Call) RunAndReturn(run func(context.Context) ([]*models.Team, error)) *OrgTeamsRepository_ListTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetParentTeam provides a mock function with given fields: ctx, teamName, parentTeamName
+func (_m *OrgTeamsRepository) SetParentTeam(ctx context.Context, teamName string, parentTeamName string) error {
+	ret := _m.Called(ctx, teamName, parentTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetParentTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, parentTeamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrgTeamsRepository_SetParentTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetParentTeam'
+type OrgTeamsRepository_SetParentTeam_Call struct {
+	*mock.Call
+}
+
+// SetParentTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - parentTeamName string
+func (_e *OrgTeamsRepository_Expecter) SetParentTeam(ctx interface{}, teamName interface{}, parentTeamName interface{}) *OrgTeamsRepository_SetParentTeam_Call {
+	return &OrgTeamsRepository_SetParentTeam_Call{Call: _e.mock.On("SetParentTeam", ctx, teamName, parentTeamName)}
+}
+
+func (_c *OrgTeamsRepository_SetParentTeam_Call) Run(run func(ctx context.Context, teamName string, parentTeamName string)) *OrgTeamsRepository_SetParentTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrgTeamsRepository_SetParentTeam_Call) Return(_a0 error) *OrgTeamsRepository_SetParentTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrgTeamsRepository_SetParentTeam_Call) RunAndReturn(run func(context.Context, string, string) error) *OrgTeamsRepository_SetParentTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrgTeamsRepository creates a new instance of OrgTeamsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrgTeamsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrgTeamsRepository {
+	mock := &OrgTeamsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
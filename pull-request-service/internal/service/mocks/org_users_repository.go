@@ -0,0 +1,243 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OrgUsersRepository is an autogenerated mock type for the OrgUsersRepository type
+type OrgUsersRepository struct {
+	mock.Mock
+}
+
+type OrgUsersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrgUsersRepository) EXPECT() *OrgUsersRepository_Expecter {
+	return &OrgUsersRepository_Expecter{mock: &_m.Mock}
+}
+
+// DetachUser provides a mock function with given fields: ctx, userID
+func (_m *OrgUsersRepository) DetachUser(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrgUsersRepository_DetachUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachUser'
+type OrgUsersRepository_DetachUser_Call struct {
+	*mock.Call
+}
+
+// DetachUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *OrgUsersRepository_Expecter) DetachUser(ctx interface{}, userID interface{}) *OrgUsersRepository_DetachUser_Call {
+	return &OrgUsersRepository_DetachUser_Call{Call: _e.mock.On("DetachUser", ctx, userID)}
+}
+
+func (_c *OrgUsersRepository_DetachUser_Call) Run(run func(ctx context.Context, userID string)) *OrgUsersRepository_DetachUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrgUsersRepository_DetachUser_Call) Return(_a0 error) *OrgUsersRepository_DetachUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrgUsersRepository_DetachUser_Call) RunAndReturn(run func(context.Context, string) error) *OrgUsersRepository_DetachUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: ctx
+func (_m *OrgUsersRepository) ListUsers(ctx context.Context) ([]*models.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgUsersRepository_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type OrgUsersRepository_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrgUsersRepository_Expecter) ListUsers(ctx interface{}) *OrgUsersRepository_ListUsers_Call {
+	return &OrgUsersRepository_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx)}
+}
+
+func (_c *OrgUsersRepository_ListUsers_Call) Run(run func(ctx context.Context)) *OrgUsersRepository_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrgUsersRepository_ListUsers_Call) Return(_a0 []*models.User, _a1 error) *OrgUsersRepository_ListUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrgUsersRepository_ListUsers_Call) RunAndReturn(run func(context.Context) ([]*models.User, error)) *OrgUsersRepository_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserActiveStatus provides a mock function with given fields: ctx, userID, isActive, until
+func (_m *OrgUsersRepository) UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) error {
+	ret := _m.Called(ctx, userID, isActive, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserActiveStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, *time.Time) error); ok {
+		r0 = rf(ctx, userID, isActive, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrgUsersRepository_UpdateUserActiveStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserActiveStatus'
+type OrgUsersRepository_UpdateUserActiveStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateUserActiveStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - isActive bool
+//   - until *time.Time
+func (_e *OrgUsersRepository_Expecter) UpdateUserActiveStatus(ctx interface{}, userID interface{}, isActive interface{}, until interface{}) *OrgUsersRepository_UpdateUserActiveStatus_Call {
+	return &OrgUsersRepository_UpdateUserActiveStatus_Call{Call: _e.mock.On("UpdateUserActiveStatus", ctx, userID, isActive, until)}
+}
+
+func (_c *OrgUsersRepository_UpdateUserActiveStatus_Call) Run(run func(ctx context.Context, userID string, isActive bool, until *time.Time)) *OrgUsersRepository_UpdateUserActiveStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *OrgUsersRepository_UpdateUserActiveStatus_Call) Return(_a0 error) *OrgUsersRepository_UpdateUserActiveStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrgUsersRepository_UpdateUserActiveStatus_Call) RunAndReturn(run func(context.Context, string, bool, *time.Time) error) *OrgUsersRepository_UpdateUserActiveStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertUser provides a mock function with given fields: ctx, userID, username, teamName, isActive
+func (_m *OrgUsersRepository) UpsertUser(ctx context.Context, userID string, username string, teamName string, isActive bool) error {
+	ret := _m.Called(ctx, userID, username, teamName, isActive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) error); ok {
+		r0 = rf(ctx, userID, username, teamName, isActive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrgUsersRepository_UpsertUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertUser'
+type OrgUsersRepository_UpsertUser_Call struct {
+	*mock.Call
+}
+
+// UpsertUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - username string
+//   - teamName string
+//   - isActive bool
+func (_e *OrgUsersRepository_Expecter) UpsertUser(ctx interface{}, userID interface{}, username interface{}, teamName interface{}, isActive interface{}) *OrgUsersRepository_UpsertUser_Call {
+	return &OrgUsersRepository_UpsertUser_Call{Call: _e.mock.On("UpsertUser", ctx, userID, username, teamName, isActive)}
+}
+
+func (_c *OrgUsersRepository_UpsertUser_Call) Run(run func(ctx context.Context, userID string, username string, teamName string, isActive bool)) *OrgUsersRepository_UpsertUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *OrgUsersRepository_UpsertUser_Call) Return(_a0 error) *OrgUsersRepository_UpsertUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrgUsersRepository_UpsertUser_Call) RunAndReturn(run func(context.Context, string, string, string, bool) error) *OrgUsersRepository_UpsertUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrgUsersRepository creates a new instance of OrgUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrgUsersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrgUsersRepository {
+	mock := &OrgUsersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PRHistoryRepository is an autogenerated mock type for the PRHistoryRepository type
+type PRHistoryRepository struct {
+	mock.Mock
+}
+
+type PRHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PRHistoryRepository) EXPECT() *PRHistoryRepository_Expecter {
+	return &PRHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddPREvent provides a mock function with given fields: ctx, prID, action, details
+func (_m *PRHistoryRepository) AddPREvent(ctx context.Context, prID string, action string, details map[string]interface{}) error {
+	ret := _m.Called(ctx, prID, action, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPREvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]interface{}) error); ok {
+		r0 = rf(ctx, prID, action, details)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRHistoryRepository_AddPREvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPREvent'
+type PRHistoryRepository_AddPREvent_Call struct {
+	*mock.Call
+}
+
+// AddPREvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - action string
+//   - details map[string]interface{}
+func (_e *PRHistoryRepository_Expecter) AddPREvent(ctx interface{}, prID interface{}, action interface{}, details interface{}) *PRHistoryRepository_AddPREvent_Call {
+	return &PRHistoryRepository_AddPREvent_Call{Call: _e.mock.On("AddPREvent", ctx, prID, action, details)}
+}
+
+func (_c *PRHistoryRepository_AddPREvent_Call) Run(run func(ctx context.Context, prID string, action string, details map[string]interface{})) *PRHistoryRepository_AddPREvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *PRHistoryRepository_AddPREvent_Call) Return(_a0 error) *PRHistoryRepository_AddPREvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRHistoryRepository_AddPREvent_Call) RunAndReturn(run func(context.Context, string, string, map[string]interface{}) error) *PRHistoryRepository_AddPREvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPRHistoryRepository creates a new instance of PRHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPRHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PRHistoryRepository {
+	mock := &PRHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,53 @@ func (_m *PullRequestRepository) EXPECT() *PullRequestRepository_Expecter {
 	return &PullRequestRepository_Expecter{mock: &_m.Mock}
 }
 
+// BumpVersion provides a mock function with given fields: ctx, prID
+func (_m *PullRequestRepository) BumpVersion(ctx context.Context, prID string) error {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PullRequestRepository_BumpVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpVersion'
+type PullRequestRepository_BumpVersion_Call struct {
+	*mock.Call
+}
+
+// BumpVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PullRequestRepository_Expecter) BumpVersion(ctx interface{}, prID interface{}) *PullRequestRepository_BumpVersion_Call {
+	return &PullRequestRepository_BumpVersion_Call{Call: _e.mock.On("BumpVersion", ctx, prID)}
+}
+
+func (_c *PullRequestRepository_BumpVersion_Call) Run(run func(ctx context.Context, prID string)) *PullRequestRepository_BumpVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepository_BumpVersion_Call) Return(_a0 error) *PullRequestRepository_BumpVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PullRequestRepository_BumpVersion_Call) RunAndReturn(run func(context.Context, string) error) *PullRequestRepository_BumpVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePR provides a mock function with given fields: ctx, pr
 func (_m *PullRequestRepository) CreatePR(ctx context.Context, pr *models.PullRequest) error {
 	ret := _m.Called(ctx, pr)
@@ -69,6 +116,53 @@ func (_c *PullRequestRepository_CreatePR_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DeletePR provides a mock function with given fields: ctx, prID
+func (_m *PullRequestRepository) DeletePR(ctx context.Context, prID string) error {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePR")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PullRequestRepository_DeletePR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePR'
+type PullRequestRepository_DeletePR_Call struct {
+	*mock.Call
+}
+
+// DeletePR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PullRequestRepository_Expecter) DeletePR(ctx interface{}, prID interface{}) *PullRequestRepository_DeletePR_Call {
+	return &PullRequestRepository_DeletePR_Call{Call: _e.mock.On("DeletePR", ctx, prID)}
+}
+
+func (_c *PullRequestRepository_DeletePR_Call) Run(run func(ctx context.Context, prID string)) *PullRequestRepository_DeletePR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepository_DeletePR_Call) Return(_a0 error) *PullRequestRepository_DeletePR_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PullRequestRepository_DeletePR_Call) RunAndReturn(run func(context.Context, string) error) *PullRequestRepository_DeletePR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPR provides a mock function with given fields: ctx, prID
 func (_m *PullRequestRepository) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
 	ret := _m.Called(ctx, prID)
@@ -128,6 +222,65 @@ func (_c *PullRequestRepository_GetPR_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetPRForUpdate provides a mock function with given fields: ctx, prID
+func (_m *PullRequestRepository) GetPRForUpdate(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPRForUpdate")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestRepository_GetPRForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPRForUpdate'
+type PullRequestRepository_GetPRForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetPRForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PullRequestRepository_Expecter) GetPRForUpdate(ctx interface{}, prID interface{}) *PullRequestRepository_GetPRForUpdate_Call {
+	return &PullRequestRepository_GetPRForUpdate_Call{Call: _e.mock.On("GetPRForUpdate", ctx, prID)}
+}
+
+func (_c *PullRequestRepository_GetPRForUpdate_Call) Run(run func(ctx context.Context, prID string)) *PullRequestRepository_GetPRForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepository_GetPRForUpdate_Call) Return(_a0 *models.PullRequest, _a1 error) *PullRequestRepository_GetPRForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestRepository_GetPRForUpdate_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PullRequestRepository_GetPRForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MergePR provides a mock function with given fields: ctx, prID
 func (_m *PullRequestRepository) MergePR(ctx context.Context, prID string) error {
 	ret := _m.Called(ctx, prID)
@@ -175,6 +328,110 @@ func (_c *PullRequestRepository_MergePR_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// PRExists provides a mock function with given fields: ctx, prID
+func (_m *PullRequestRepository) PRExists(ctx context.Context, prID string) (bool, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PRExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestRepository_PRExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PRExists'
+type PullRequestRepository_PRExists_Call struct {
+	*mock.Call
+}
+
+// PRExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PullRequestRepository_Expecter) PRExists(ctx interface{}, prID interface{}) *PullRequestRepository_PRExists_Call {
+	return &PullRequestRepository_PRExists_Call{Call: _e.mock.On("PRExists", ctx, prID)}
+}
+
+func (_c *PullRequestRepository_PRExists_Call) Run(run func(ctx context.Context, prID string)) *PullRequestRepository_PRExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepository_PRExists_Call) Return(_a0 bool, _a1 error) *PullRequestRepository_PRExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestRepository_PRExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *PullRequestRepository_PRExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeletePR provides a mock function with given fields: ctx, prID
+func (_m *PullRequestRepository) SoftDeletePR(ctx context.Context, prID string) error {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeletePR")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PullRequestRepository_SoftDeletePR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeletePR'
+type PullRequestRepository_SoftDeletePR_Call struct {
+	*mock.Call
+}
+
+// SoftDeletePR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PullRequestRepository_Expecter) SoftDeletePR(ctx interface{}, prID interface{}) *PullRequestRepository_SoftDeletePR_Call {
+	return &PullRequestRepository_SoftDeletePR_Call{Call: _e.mock.On("SoftDeletePR", ctx, prID)}
+}
+
+func (_c *PullRequestRepository_SoftDeletePR_Call) Run(run func(ctx context.Context, prID string)) *PullRequestRepository_SoftDeletePR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepository_SoftDeletePR_Call) Return(_a0 error) *PullRequestRepository_SoftDeletePR_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PullRequestRepository_SoftDeletePR_Call) RunAndReturn(run func(context.Context, string) error) *PullRequestRepository_SoftDeletePR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPullRequestRepository creates a new instance of PullRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPullRequestRepository(t interface {
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ReviewEvents is an autogenerated mock type for the ReviewEvents type
+type ReviewEvents struct {
+	mock.Mock
+}
+
+type ReviewEvents_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewEvents) EXPECT() *ReviewEvents_Expecter {
+	return &ReviewEvents_Expecter{mock: &_m.Mock}
+}
+
+// NoCandidate provides a mock function with no fields
+func (_m *ReviewEvents) NoCandidate() {
+	_m.Called()
+}
+
+// ReviewEvents_NoCandidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoCandidate'
+type ReviewEvents_NoCandidate_Call struct {
+	*mock.Call
+}
+
+// NoCandidate is a helper method to define mock.On call
+func (_e *ReviewEvents_Expecter) NoCandidate() *ReviewEvents_NoCandidate_Call {
+	return &ReviewEvents_NoCandidate_Call{Call: _e.mock.On("NoCandidate")}
+}
+
+func (_c *ReviewEvents_NoCandidate_Call) Run(run func()) *ReviewEvents_NoCandidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReviewEvents_NoCandidate_Call) Return() *ReviewEvents_NoCandidate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReviewEvents_NoCandidate_Call) RunAndReturn(run func()) *ReviewEvents_NoCandidate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reassigned provides a mock function with no fields
+func (_m *ReviewEvents) Reassigned() {
+	_m.Called()
+}
+
+// ReviewEvents_Reassigned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reassigned'
+type ReviewEvents_Reassigned_Call struct {
+	*mock.Call
+}
+
+// Reassigned is a helper method to define mock.On call
+func (_e *ReviewEvents_Expecter) Reassigned() *ReviewEvents_Reassigned_Call {
+	return &ReviewEvents_Reassigned_Call{Call: _e.mock.On("Reassigned")}
+}
+
+func (_c *ReviewEvents_Reassigned_Call) Run(run func()) *ReviewEvents_Reassigned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReviewEvents_Reassigned_Call) Return() *ReviewEvents_Reassigned_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReviewEvents_Reassigned_Call) RunAndReturn(run func()) *ReviewEvents_Reassigned_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewReviewEvents creates a new instance of ReviewEvents. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewEvents(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewEvents {
+	mock := &ReviewEvents{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
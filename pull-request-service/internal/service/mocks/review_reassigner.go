@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewReassigner is an autogenerated mock type for the ReviewReassigner type
+type ReviewReassigner struct {
+	mock.Mock
+}
+
+type ReviewReassigner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewReassigner) EXPECT() *ReviewReassigner_Expecter {
+	return &ReviewReassigner_Expecter{mock: &_m.Mock}
+}
+
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, expectedVersion
+func (_m *ReviewReassigner) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, expectedVersion int) (*models.PullRequest, string, error) {
+	ret := _m.Called(ctx, prID, oldReviewerID, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignReviewer")
+	}
+
+	var r0 *models.PullRequest
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) (*models.PullRequest, string, error)); ok {
+		return rf(ctx, prID, oldReviewerID, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, oldReviewerID, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) string); ok {
+		r1 = rf(ctx, prID, oldReviewerID, expectedVersion)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int) error); ok {
+		r2 = rf(ctx, prID, oldReviewerID, expectedVersion)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReviewReassigner_ReassignReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignReviewer'
+type ReviewReassigner_ReassignReviewer_Call struct {
+	*mock.Call
+}
+
+// ReassignReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - oldReviewerID string
+//   - expectedVersion int
+func (_e *ReviewReassigner_Expecter) ReassignReviewer(ctx interface{}, prID interface{}, oldReviewerID interface{}, expectedVersion interface{}) *ReviewReassigner_ReassignReviewer_Call {
+	return &ReviewReassigner_ReassignReviewer_Call{Call: _e.mock.On("ReassignReviewer", ctx, prID, oldReviewerID, expectedVersion)}
+}
+
+func (_c *ReviewReassigner_ReassignReviewer_Call) Run(run func(ctx context.Context, prID string, oldReviewerID string, expectedVersion int)) *ReviewReassigner_ReassignReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ReviewReassigner_ReassignReviewer_Call) Return(_a0 *models.PullRequest, _a1 string, _a2 error) *ReviewReassigner_ReassignReviewer_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ReviewReassigner_ReassignReviewer_Call) RunAndReturn(run func(context.Context, string, string, int) (*models.PullRequest, string, error)) *ReviewReassigner_ReassignReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, newReviewerID
+func (_m *ReviewReassigner) ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, oldReviewerID, newReviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewer")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, oldReviewerID, newReviewerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, oldReviewerID, newReviewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, prID, oldReviewerID, newReviewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewReassigner_ReplaceReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewer'
+type ReviewReassigner_ReplaceReviewer_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - oldReviewerID string
+//   - newReviewerID string
+func (_e *ReviewReassigner_Expecter) ReplaceReviewer(ctx interface{}, prID interface{}, oldReviewerID interface{}, newReviewerID interface{}) *ReviewReassigner_ReplaceReviewer_Call {
+	return &ReviewReassigner_ReplaceReviewer_Call{Call: _e.mock.On("ReplaceReviewer", ctx, prID, oldReviewerID, newReviewerID)}
+}
+
+func (_c *ReviewReassigner_ReplaceReviewer_Call) Run(run func(ctx context.Context, prID string, oldReviewerID string, newReviewerID string)) *ReviewReassigner_ReplaceReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ReviewReassigner_ReplaceReviewer_Call) Return(_a0 *models.PullRequest, _a1 error) *ReviewReassigner_ReplaceReviewer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewReassigner_ReplaceReviewer_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.PullRequest, error)) *ReviewReassigner_ReplaceReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewReassigner creates a new instance of ReviewReassigner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewReassigner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewReassigner {
+	mock := &ReviewReassigner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -188,6 +188,53 @@ func (_c *ReviewRepository_GetPRsByReviewer_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// RemoveAllReviewers provides a mock function with given fields: ctx, prID
+func (_m *ReviewRepository) RemoveAllReviewers(ctx context.Context, prID string) error {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAllReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReviewRepository_RemoveAllReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAllReviewers'
+type ReviewRepository_RemoveAllReviewers_Call struct {
+	*mock.Call
+}
+
+// RemoveAllReviewers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *ReviewRepository_Expecter) RemoveAllReviewers(ctx interface{}, prID interface{}) *ReviewRepository_RemoveAllReviewers_Call {
+	return &ReviewRepository_RemoveAllReviewers_Call{Call: _e.mock.On("RemoveAllReviewers", ctx, prID)}
+}
+
+func (_c *ReviewRepository_RemoveAllReviewers_Call) Run(run func(ctx context.Context, prID string)) *ReviewRepository_RemoveAllReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReviewRepository_RemoveAllReviewers_Call) Return(_a0 error) *ReviewRepository_RemoveAllReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReviewRepository_RemoveAllReviewers_Call) RunAndReturn(run func(context.Context, string) error) *ReviewRepository_RemoveAllReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveReviewer provides a mock function with given fields: ctx, prID, userID
 func (_m *ReviewRepository) RemoveReviewer(ctx context.Context, prID string, userID string) error {
 	ret := _m.Called(ctx, prID, userID)
@@ -236,6 +283,66 @@ func (_c *ReviewRepository_RemoveReviewer_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// StartReview provides a mock function with given fields: ctx, prID, userID
+func (_m *ReviewRepository) StartReview(ctx context.Context, prID string, userID string) (*models.ReviewAssignment, error) {
+	ret := _m.Called(ctx, prID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartReview")
+	}
+
+	var r0 *models.ReviewAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.ReviewAssignment, error)); ok {
+		return rf(ctx, prID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.ReviewAssignment); ok {
+		r0 = rf(ctx, prID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReviewAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, prID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewRepository_StartReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartReview'
+type ReviewRepository_StartReview_Call struct {
+	*mock.Call
+}
+
+// StartReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - userID string
+func (_e *ReviewRepository_Expecter) StartReview(ctx interface{}, prID interface{}, userID interface{}) *ReviewRepository_StartReview_Call {
+	return &ReviewRepository_StartReview_Call{Call: _e.mock.On("StartReview", ctx, prID, userID)}
+}
+
+func (_c *ReviewRepository_StartReview_Call) Run(run func(ctx context.Context, prID string, userID string)) *ReviewRepository_StartReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ReviewRepository_StartReview_Call) Return(_a0 *models.ReviewAssignment, _a1 error) *ReviewRepository_StartReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewRepository_StartReview_Call) RunAndReturn(run func(context.Context, string, string) (*models.ReviewAssignment, error)) *ReviewRepository_StartReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewReviewRepository creates a new instance of ReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewReviewRepository(t interface {
@@ -0,0 +1,602 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// StatsRepository is an autogenerated mock type for the StatsRepository type
+type StatsRepository struct {
+	mock.Mock
+}
+
+type StatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsRepository) EXPECT() *StatsRepository_Expecter {
+	return &StatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DiffReviewerCounters provides a mock function with given fields: ctx
+func (_m *StatsRepository) DiffReviewerCounters(ctx context.Context) ([]*models.CounterDrift, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffReviewerCounters")
+	}
+
+	var r0 []*models.CounterDrift
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.CounterDrift, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.CounterDrift); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CounterDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_DiffReviewerCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffReviewerCounters'
+type StatsRepository_DiffReviewerCounters_Call struct {
+	*mock.Call
+}
+
+// DiffReviewerCounters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsRepository_Expecter) DiffReviewerCounters(ctx interface{}) *StatsRepository_DiffReviewerCounters_Call {
+	return &StatsRepository_DiffReviewerCounters_Call{Call: _e.mock.On("DiffReviewerCounters", ctx)}
+}
+
+func (_c *StatsRepository_DiffReviewerCounters_Call) Run(run func(ctx context.Context)) *StatsRepository_DiffReviewerCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_DiffReviewerCounters_Call) Return(_a0 []*models.CounterDrift, _a1 error) *StatsRepository_DiffReviewerCounters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_DiffReviewerCounters_Call) RunAndReturn(run func(context.Context) ([]*models.CounterDrift, error)) *StatsRepository_DiffReviewerCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCycleTime provides a mock function with given fields: ctx, q
+func (_m *StatsRepository) GetCycleTime(ctx context.Context, q *models.CycleTimeQuery) ([]*models.CycleTimeStats, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCycleTime")
+	}
+
+	var r0 []*models.CycleTimeStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CycleTimeQuery) ([]*models.CycleTimeStats, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CycleTimeQuery) []*models.CycleTimeStats); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CycleTimeStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CycleTimeQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetCycleTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCycleTime'
+type StatsRepository_GetCycleTime_Call struct {
+	*mock.Call
+}
+
+// GetCycleTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.CycleTimeQuery
+func (_e *StatsRepository_Expecter) GetCycleTime(ctx interface{}, q interface{}) *StatsRepository_GetCycleTime_Call {
+	return &StatsRepository_GetCycleTime_Call{Call: _e.mock.On("GetCycleTime", ctx, q)}
+}
+
+func (_c *StatsRepository_GetCycleTime_Call) Run(run func(ctx context.Context, q *models.CycleTimeQuery)) *StatsRepository_GetCycleTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CycleTimeQuery))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetCycleTime_Call) Return(_a0 []*models.CycleTimeStats, _a1 error) *StatsRepository_GetCycleTime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetCycleTime_Call) RunAndReturn(run func(context.Context, *models.CycleTimeQuery) ([]*models.CycleTimeStats, error)) *StatsRepository_GetCycleTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDailyActivity provides a mock function with given fields: ctx, teamName, since
+func (_m *StatsRepository) GetDailyActivity(ctx context.Context, teamName string, since time.Time) ([]*models.DailyActivity, error) {
+	ret := _m.Called(ctx, teamName, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyActivity")
+	}
+
+	var r0 []*models.DailyActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*models.DailyActivity, error)); ok {
+		return rf(ctx, teamName, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*models.DailyActivity); ok {
+		r0 = rf(ctx, teamName, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DailyActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, teamName, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetDailyActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyActivity'
+type StatsRepository_GetDailyActivity_Call struct {
+	*mock.Call
+}
+
+// GetDailyActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - since time.Time
+func (_e *StatsRepository_Expecter) GetDailyActivity(ctx interface{}, teamName interface{}, since interface{}) *StatsRepository_GetDailyActivity_Call {
+	return &StatsRepository_GetDailyActivity_Call{Call: _e.mock.On("GetDailyActivity", ctx, teamName, since)}
+}
+
+func (_c *StatsRepository_GetDailyActivity_Call) Run(run func(ctx context.Context, teamName string, since time.Time)) *StatsRepository_GetDailyActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetDailyActivity_Call) Return(_a0 []*models.DailyActivity, _a1 error) *StatsRepository_GetDailyActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetDailyActivity_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]*models.DailyActivity, error)) *StatsRepository_GetDailyActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadTrend provides a mock function with given fields: ctx, q
+func (_m *StatsRepository) GetLoadTrend(ctx context.Context, q *models.LoadTrendQuery) ([]*models.LoadTrendPoint, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoadTrend")
+	}
+
+	var r0 []*models.LoadTrendPoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.LoadTrendQuery) ([]*models.LoadTrendPoint, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.LoadTrendQuery) []*models.LoadTrendPoint); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.LoadTrendPoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.LoadTrendQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetLoadTrend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadTrend'
+type StatsRepository_GetLoadTrend_Call struct {
+	*mock.Call
+}
+
+// GetLoadTrend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.LoadTrendQuery
+func (_e *StatsRepository_Expecter) GetLoadTrend(ctx interface{}, q interface{}) *StatsRepository_GetLoadTrend_Call {
+	return &StatsRepository_GetLoadTrend_Call{Call: _e.mock.On("GetLoadTrend", ctx, q)}
+}
+
+func (_c *StatsRepository_GetLoadTrend_Call) Run(run func(ctx context.Context, q *models.LoadTrendQuery)) *StatsRepository_GetLoadTrend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.LoadTrendQuery))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetLoadTrend_Call) Return(_a0 []*models.LoadTrendPoint, _a1 error) *StatsRepository_GetLoadTrend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetLoadTrend_Call) RunAndReturn(run func(context.Context, *models.LoadTrendQuery) ([]*models.LoadTrendPoint, error)) *StatsRepository_GetLoadTrend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberAssignments provides a mock function with given fields: ctx, q
+func (_m *StatsRepository) GetMemberAssignments(ctx context.Context, q *models.FairnessQuery) ([]*models.MemberAssignments, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberAssignments")
+	}
+
+	var r0 []*models.MemberAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.FairnessQuery) ([]*models.MemberAssignments, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.FairnessQuery) []*models.MemberAssignments); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.MemberAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.FairnessQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetMemberAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberAssignments'
+type StatsRepository_GetMemberAssignments_Call struct {
+	*mock.Call
+}
+
+// GetMemberAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.FairnessQuery
+func (_e *StatsRepository_Expecter) GetMemberAssignments(ctx interface{}, q interface{}) *StatsRepository_GetMemberAssignments_Call {
+	return &StatsRepository_GetMemberAssignments_Call{Call: _e.mock.On("GetMemberAssignments", ctx, q)}
+}
+
+func (_c *StatsRepository_GetMemberAssignments_Call) Run(run func(ctx context.Context, q *models.FairnessQuery)) *StatsRepository_GetMemberAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.FairnessQuery))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetMemberAssignments_Call) Return(_a0 []*models.MemberAssignments, _a1 error) *StatsRepository_GetMemberAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetMemberAssignments_Call) RunAndReturn(run func(context.Context, *models.FairnessQuery) ([]*models.MemberAssignments, error)) *StatsRepository_GetMemberAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenPullRequests provides a mock function with given fields: ctx, teamName
+func (_m *StatsRepository) GetOpenPullRequests(ctx context.Context, teamName string) ([]*models.OpenPullRequest, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenPullRequests")
+	}
+
+	var r0 []*models.OpenPullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.OpenPullRequest, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.OpenPullRequest); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.OpenPullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetOpenPullRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenPullRequests'
+type StatsRepository_GetOpenPullRequests_Call struct {
+	*mock.Call
+}
+
+// GetOpenPullRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *StatsRepository_Expecter) GetOpenPullRequests(ctx interface{}, teamName interface{}) *StatsRepository_GetOpenPullRequests_Call {
+	return &StatsRepository_GetOpenPullRequests_Call{Call: _e.mock.On("GetOpenPullRequests", ctx, teamName)}
+}
+
+func (_c *StatsRepository_GetOpenPullRequests_Call) Run(run func(ctx context.Context, teamName string)) *StatsRepository_GetOpenPullRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetOpenPullRequests_Call) Return(_a0 []*models.OpenPullRequest, _a1 error) *StatsRepository_GetOpenPullRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetOpenPullRequests_Call) RunAndReturn(run func(context.Context, string) ([]*models.OpenPullRequest, error)) *StatsRepository_GetOpenPullRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOverdueReviews provides a mock function with given fields: ctx, teamName, assignedBefore
+func (_m *StatsRepository) GetOverdueReviews(ctx context.Context, teamName string, assignedBefore time.Time) ([]*models.OverdueReview, error) {
+	ret := _m.Called(ctx, teamName, assignedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueReviews")
+	}
+
+	var r0 []*models.OverdueReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*models.OverdueReview, error)); ok {
+		return rf(ctx, teamName, assignedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*models.OverdueReview); ok {
+		r0 = rf(ctx, teamName, assignedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.OverdueReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, teamName, assignedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetOverdueReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverdueReviews'
+type StatsRepository_GetOverdueReviews_Call struct {
+	*mock.Call
+}
+
+// GetOverdueReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - assignedBefore time.Time
+func (_e *StatsRepository_Expecter) GetOverdueReviews(ctx interface{}, teamName interface{}, assignedBefore interface{}) *StatsRepository_GetOverdueReviews_Call {
+	return &StatsRepository_GetOverdueReviews_Call{Call: _e.mock.On("GetOverdueReviews", ctx, teamName, assignedBefore)}
+}
+
+func (_c *StatsRepository_GetOverdueReviews_Call) Run(run func(ctx context.Context, teamName string, assignedBefore time.Time)) *StatsRepository_GetOverdueReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetOverdueReviews_Call) Return(_a0 []*models.OverdueReview, _a1 error) *StatsRepository_GetOverdueReviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetOverdueReviews_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]*models.OverdueReview, error)) *StatsRepository_GetOverdueReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockReviewerCounters provides a mock function with given fields: ctx
+func (_m *StatsRepository) LockReviewerCounters(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockReviewerCounters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepository_LockReviewerCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockReviewerCounters'
+type StatsRepository_LockReviewerCounters_Call struct {
+	*mock.Call
+}
+
+// LockReviewerCounters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsRepository_Expecter) LockReviewerCounters(ctx interface{}) *StatsRepository_LockReviewerCounters_Call {
+	return &StatsRepository_LockReviewerCounters_Call{Call: _e.mock.On("LockReviewerCounters", ctx)}
+}
+
+func (_c *StatsRepository_LockReviewerCounters_Call) Run(run func(ctx context.Context)) *StatsRepository_LockReviewerCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_LockReviewerCounters_Call) Return(_a0 error) *StatsRepository_LockReviewerCounters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepository_LockReviewerCounters_Call) RunAndReturn(run func(context.Context) error) *StatsRepository_LockReviewerCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildReviewerCounters provides a mock function with given fields: ctx
+func (_m *StatsRepository) RebuildReviewerCounters(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildReviewerCounters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepository_RebuildReviewerCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildReviewerCounters'
+type StatsRepository_RebuildReviewerCounters_Call struct {
+	*mock.Call
+}
+
+// RebuildReviewerCounters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsRepository_Expecter) RebuildReviewerCounters(ctx interface{}) *StatsRepository_RebuildReviewerCounters_Call {
+	return &StatsRepository_RebuildReviewerCounters_Call{Call: _e.mock.On("RebuildReviewerCounters", ctx)}
+}
+
+func (_c *StatsRepository_RebuildReviewerCounters_Call) Run(run func(ctx context.Context)) *StatsRepository_RebuildReviewerCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_RebuildReviewerCounters_Call) Return(_a0 error) *StatsRepository_RebuildReviewerCounters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepository_RebuildReviewerCounters_Call) RunAndReturn(run func(context.Context) error) *StatsRepository_RebuildReviewerCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeLoadSnapshot provides a mock function with given fields: ctx, day
+func (_m *StatsRepository) TakeLoadSnapshot(ctx context.Context, day time.Time) (int, error) {
+	ret := _m.Called(ctx, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeLoadSnapshot")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, day)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, day)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, day)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_TakeLoadSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeLoadSnapshot'
+type StatsRepository_TakeLoadSnapshot_Call struct {
+	*mock.Call
+}
+
+// TakeLoadSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - day time.Time
+func (_e *StatsRepository_Expecter) TakeLoadSnapshot(ctx interface{}, day interface{}) *StatsRepository_TakeLoadSnapshot_Call {
+	return &StatsRepository_TakeLoadSnapshot_Call{Call: _e.mock.On("TakeLoadSnapshot", ctx, day)}
+}
+
+func (_c *StatsRepository_TakeLoadSnapshot_Call) Run(run func(ctx context.Context, day time.Time)) *StatsRepository_TakeLoadSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_TakeLoadSnapshot_Call) Return(_a0 int, _a1 error) *StatsRepository_TakeLoadSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_TakeLoadSnapshot_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *StatsRepository_TakeLoadSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsRepository creates a new instance of StatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsRepository {
+	mock := &StatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	models "pull-request-service/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -21,24 +22,24 @@ func (_m *TeamInfoRepository) EXPECT() *TeamInfoRepository_Expecter {
 	return &TeamInfoRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetActiveTeamMembers provides a mock function with given fields: ctx, teamName, excludeUserID
-func (_m *TeamInfoRepository) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]string, error) {
+// GetActiveTeamCandidates provides a mock function with given fields: ctx, teamName, excludeUserID
+func (_m *TeamInfoRepository) GetActiveTeamCandidates(ctx context.Context, teamName string, excludeUserID string) ([]*models.ReviewCandidate, error) {
 	ret := _m.Called(ctx, teamName, excludeUserID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetActiveTeamMembers")
+		panic("no return value specified for GetActiveTeamCandidates")
 	}
 
-	var r0 []string
+	var r0 []*models.ReviewCandidate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*models.ReviewCandidate, error)); ok {
 		return rf(ctx, teamName, excludeUserID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*models.ReviewCandidate); ok {
 		r0 = rf(ctx, teamName, excludeUserID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]*models.ReviewCandidate)
 		}
 	}
 
@@ -51,55 +52,173 @@ func (_m *TeamInfoRepository) GetActiveTeamMembers(ctx context.Context, teamName
 	return r0, r1
 }
 
-// TeamInfoRepository_GetActiveTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveTeamMembers'
-type TeamInfoRepository_GetActiveTeamMembers_Call struct {
+// TeamInfoRepository_GetActiveTeamCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveTeamCandidates'
+type TeamInfoRepository_GetActiveTeamCandidates_Call struct {
 	*mock.Call
 }
 
-// GetActiveTeamMembers is a helper method to define mock.On call
+// GetActiveTeamCandidates is a helper method to define mock.On call
 //   - ctx context.Context
 //   - teamName string
 //   - excludeUserID string
-func (_e *TeamInfoRepository_Expecter) GetActiveTeamMembers(ctx interface{}, teamName interface{}, excludeUserID interface{}) *TeamInfoRepository_GetActiveTeamMembers_Call {
-	return &TeamInfoRepository_GetActiveTeamMembers_Call{Call: _e.mock.On("GetActiveTeamMembers", ctx, teamName, excludeUserID)}
+func (_e *TeamInfoRepository_Expecter) GetActiveTeamCandidates(ctx interface{}, teamName interface{}, excludeUserID interface{}) *TeamInfoRepository_GetActiveTeamCandidates_Call {
+	return &TeamInfoRepository_GetActiveTeamCandidates_Call{Call: _e.mock.On("GetActiveTeamCandidates", ctx, teamName, excludeUserID)}
 }
 
-func (_c *TeamInfoRepository_GetActiveTeamMembers_Call) Run(run func(ctx context.Context, teamName string, excludeUserID string)) *TeamInfoRepository_GetActiveTeamMembers_Call {
+func (_c *TeamInfoRepository_GetActiveTeamCandidates_Call) Run(run func(ctx context.Context, teamName string, excludeUserID string)) *TeamInfoRepository_GetActiveTeamCandidates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TeamInfoRepository_GetActiveTeamMembers_Call) Return(_a0 []string, _a1 error) *TeamInfoRepository_GetActiveTeamMembers_Call {
+func (_c *TeamInfoRepository_GetActiveTeamCandidates_Call) Return(_a0 []*models.ReviewCandidate, _a1 error) *TeamInfoRepository_GetActiveTeamCandidates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TeamInfoRepository_GetActiveTeamMembers_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *TeamInfoRepository_GetActiveTeamMembers_Call {
+func (_c *TeamInfoRepository_GetActiveTeamCandidates_Call) RunAndReturn(run func(context.Context, string, string) ([]*models.ReviewCandidate, error)) *TeamInfoRepository_GetActiveTeamCandidates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserTeam provides a mock function with given fields: ctx, userID
-func (_m *TeamInfoRepository) GetUserTeam(ctx context.Context, userID string) (string, error) {
-	ret := _m.Called(ctx, userID)
+// GetChildTeams provides a mock function with given fields: ctx, teamName
+func (_m *TeamInfoRepository) GetChildTeams(ctx context.Context, teamName string) ([]string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildTeams")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInfoRepository_GetChildTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildTeams'
+type TeamInfoRepository_GetChildTeams_Call struct {
+	*mock.Call
+}
+
+// GetChildTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamInfoRepository_Expecter) GetChildTeams(ctx interface{}, teamName interface{}) *TeamInfoRepository_GetChildTeams_Call {
+	return &TeamInfoRepository_GetChildTeams_Call{Call: _e.mock.On("GetChildTeams", ctx, teamName)}
+}
+
+func (_c *TeamInfoRepository_GetChildTeams_Call) Run(run func(ctx context.Context, teamName string)) *TeamInfoRepository_GetChildTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamInfoRepository_GetChildTeams_Call) Return(_a0 []string, _a1 error) *TeamInfoRepository_GetChildTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInfoRepository_GetChildTeams_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *TeamInfoRepository_GetChildTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParentTeam provides a mock function with given fields: ctx, teamName
+func (_m *TeamInfoRepository) GetParentTeam(ctx context.Context, teamName string) (string, error) {
+	ret := _m.Called(ctx, teamName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserTeam")
+		panic("no return value specified for GetParentTeam")
 	}
 
 	var r0 string
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, userID)
+		return rf(ctx, teamName)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, userID)
+		r0 = rf(ctx, teamName)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInfoRepository_GetParentTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParentTeam'
+type TeamInfoRepository_GetParentTeam_Call struct {
+	*mock.Call
+}
+
+// GetParentTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamInfoRepository_Expecter) GetParentTeam(ctx interface{}, teamName interface{}) *TeamInfoRepository_GetParentTeam_Call {
+	return &TeamInfoRepository_GetParentTeam_Call{Call: _e.mock.On("GetParentTeam", ctx, teamName)}
+}
+
+func (_c *TeamInfoRepository_GetParentTeam_Call) Run(run func(ctx context.Context, teamName string)) *TeamInfoRepository_GetParentTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamInfoRepository_GetParentTeam_Call) Return(_a0 string, _a1 error) *TeamInfoRepository_GetParentTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInfoRepository_GetParentTeam_Call) RunAndReturn(run func(context.Context, string) (string, error)) *TeamInfoRepository_GetParentTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTeams provides a mock function with given fields: ctx, userID
+func (_m *TeamInfoRepository) GetUserTeams(ctx context.Context, userID string) ([]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTeams")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, userID)
 	} else {
@@ -109,31 +228,31 @@ func (_m *TeamInfoRepository) GetUserTeam(ctx context.Context, userID string) (s
 	return r0, r1
 }
 
-// TeamInfoRepository_GetUserTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTeam'
-type TeamInfoRepository_GetUserTeam_Call struct {
+// TeamInfoRepository_GetUserTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTeams'
+type TeamInfoRepository_GetUserTeams_Call struct {
 	*mock.Call
 }
 
-// GetUserTeam is a helper method to define mock.On call
+// GetUserTeams is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *TeamInfoRepository_Expecter) GetUserTeam(ctx interface{}, userID interface{}) *TeamInfoRepository_GetUserTeam_Call {
-	return &TeamInfoRepository_GetUserTeam_Call{Call: _e.mock.On("GetUserTeam", ctx, userID)}
+func (_e *TeamInfoRepository_Expecter) GetUserTeams(ctx interface{}, userID interface{}) *TeamInfoRepository_GetUserTeams_Call {
+	return &TeamInfoRepository_GetUserTeams_Call{Call: _e.mock.On("GetUserTeams", ctx, userID)}
 }
 
-func (_c *TeamInfoRepository_GetUserTeam_Call) Run(run func(ctx context.Context, userID string)) *TeamInfoRepository_GetUserTeam_Call {
+func (_c *TeamInfoRepository_GetUserTeams_Call) Run(run func(ctx context.Context, userID string)) *TeamInfoRepository_GetUserTeams_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *TeamInfoRepository_GetUserTeam_Call) Return(_a0 string, _a1 error) *TeamInfoRepository_GetUserTeam_Call {
+func (_c *TeamInfoRepository_GetUserTeams_Call) Return(_a0 []string, _a1 error) *TeamInfoRepository_GetUserTeams_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TeamInfoRepository_GetUserTeam_Call) RunAndReturn(run func(context.Context, string) (string, error)) *TeamInfoRepository_GetUserTeam_Call {
+func (_c *TeamInfoRepository_GetUserTeams_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *TeamInfoRepository_GetUserTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
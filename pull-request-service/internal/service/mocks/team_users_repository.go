@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	models "pull-request-service/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -21,6 +22,112 @@ func (_m *TeamUsersRepository) EXPECT() *TeamUsersRepository_Expecter {
 	return &TeamUsersRepository_Expecter{mock: &_m.Mock}
 }
 
+// DetachUser provides a mock function with given fields: ctx, userID
+func (_m *TeamUsersRepository) DetachUser(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamUsersRepository_DetachUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachUser'
+type TeamUsersRepository_DetachUser_Call struct {
+	*mock.Call
+}
+
+// DetachUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TeamUsersRepository_Expecter) DetachUser(ctx interface{}, userID interface{}) *TeamUsersRepository_DetachUser_Call {
+	return &TeamUsersRepository_DetachUser_Call{Call: _e.mock.On("DetachUser", ctx, userID)}
+}
+
+func (_c *TeamUsersRepository_DetachUser_Call) Run(run func(ctx context.Context, userID string)) *TeamUsersRepository_DetachUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamUsersRepository_DetachUser_Call) Return(_a0 error) *TeamUsersRepository_DetachUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamUsersRepository_DetachUser_Call) RunAndReturn(run func(context.Context, string) error) *TeamUsersRepository_DetachUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx, userIDs
+func (_m *TeamUsersRepository) GetUsers(ctx context.Context, userIDs []string) ([]*models.User, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*models.User, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*models.User); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamUsersRepository_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type TeamUsersRepository_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *TeamUsersRepository_Expecter) GetUsers(ctx interface{}, userIDs interface{}) *TeamUsersRepository_GetUsers_Call {
+	return &TeamUsersRepository_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, userIDs)}
+}
+
+func (_c *TeamUsersRepository_GetUsers_Call) Run(run func(ctx context.Context, userIDs []string)) *TeamUsersRepository_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TeamUsersRepository_GetUsers_Call) Return(_a0 []*models.User, _a1 error) *TeamUsersRepository_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamUsersRepository_GetUsers_Call) RunAndReturn(run func(context.Context, []string) ([]*models.User, error)) *TeamUsersRepository_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertUser provides a mock function with given fields: ctx, userID, username, teamName, isActive
 func (_m *TeamUsersRepository) UpsertUser(ctx context.Context, userID string, username string, teamName string, isActive bool) error {
 	ret := _m.Called(ctx, userID, username, teamName, isActive)
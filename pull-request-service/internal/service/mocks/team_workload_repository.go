@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "pull-request-service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TeamWorkloadRepository is an autogenerated mock type for the TeamWorkloadRepository type
+type TeamWorkloadRepository struct {
+	mock.Mock
+}
+
+type TeamWorkloadRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamWorkloadRepository) EXPECT() *TeamWorkloadRepository_Expecter {
+	return &TeamWorkloadRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMembersWorkload provides a mock function with given fields: ctx, userIDs
+func (_m *TeamWorkloadRepository) GetMembersWorkload(ctx context.Context, userIDs []string) (map[string]*models.MemberWorkload, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembersWorkload")
+	}
+
+	var r0 map[string]*models.MemberWorkload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*models.MemberWorkload, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*models.MemberWorkload); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.MemberWorkload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamWorkloadRepository_GetMembersWorkload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembersWorkload'
+type TeamWorkloadRepository_GetMembersWorkload_Call struct {
+	*mock.Call
+}
+
+// GetMembersWorkload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *TeamWorkloadRepository_Expecter) GetMembersWorkload(ctx interface{}, userIDs interface{}) *TeamWorkloadRepository_GetMembersWorkload_Call {
+	return &TeamWorkloadRepository_GetMembersWorkload_Call{Call: _e.mock.On("GetMembersWorkload", ctx, userIDs)}
+}
+
+func (_c *TeamWorkloadRepository_GetMembersWorkload_Call) Run(run func(ctx context.Context, userIDs []string)) *TeamWorkloadRepository_GetMembersWorkload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TeamWorkloadRepository_GetMembersWorkload_Call) Return(_a0 map[string]*models.MemberWorkload, _a1 error) *TeamWorkloadRepository_GetMembersWorkload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamWorkloadRepository_GetMembersWorkload_Call) RunAndReturn(run func(context.Context, []string) (map[string]*models.MemberWorkload, error)) *TeamWorkloadRepository_GetMembersWorkload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamWorkloadRepository creates a new instance of TeamWorkloadRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamWorkloadRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamWorkloadRepository {
+	mock := &TeamWorkloadRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,110 @@ func (_m *TeamsRepository) EXPECT() *TeamsRepository_Expecter {
 	return &TeamsRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountMembers provides a mock function with given fields: ctx, teamName
+func (_m *TeamsRepository) CountMembers(ctx context.Context, teamName string) (int, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountMembers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_CountMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMembers'
+type TeamsRepository_CountMembers_Call struct {
+	*mock.Call
+}
+
+// CountMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamsRepository_Expecter) CountMembers(ctx interface{}, teamName interface{}) *TeamsRepository_CountMembers_Call {
+	return &TeamsRepository_CountMembers_Call{Call: _e.mock.On("CountMembers", ctx, teamName)}
+}
+
+func (_c *TeamsRepository_CountMembers_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_CountMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_CountMembers_Call) Return(_a0 int, _a1 error) *TeamsRepository_CountMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_CountMembers_Call) RunAndReturn(run func(context.Context, string) (int, error)) *TeamsRepository_CountMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, teamName
+func (_m *TeamsRepository) DeleteTeam(ctx context.Context, teamName string) error {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamsRepository_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type TeamsRepository_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamsRepository_Expecter) DeleteTeam(ctx interface{}, teamName interface{}) *TeamsRepository_DeleteTeam_Call {
+	return &TeamsRepository_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, teamName)}
+}
+
+func (_c *TeamsRepository_DeleteTeam_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_DeleteTeam_Call) Return(_a0 error) *TeamsRepository_DeleteTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamsRepository_DeleteTeam_Call) RunAndReturn(run func(context.Context, string) error) *TeamsRepository_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetActiveTeamMembers provides a mock function with given fields: ctx, teamName, excludeUserID
 func (_m *TeamsRepository) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]string, error) {
 	ret := _m.Called(ctx, teamName, excludeUserID)
@@ -82,6 +186,122 @@ func (_c *TeamsRepository_GetActiveTeamMembers_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetChildTeams provides a mock function with given fields: ctx, teamName
+func (_m *TeamsRepository) GetChildTeams(ctx context.Context, teamName string) ([]string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildTeams")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_GetChildTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildTeams'
+type TeamsRepository_GetChildTeams_Call struct {
+	*mock.Call
+}
+
+// GetChildTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamsRepository_Expecter) GetChildTeams(ctx interface{}, teamName interface{}) *TeamsRepository_GetChildTeams_Call {
+	return &TeamsRepository_GetChildTeams_Call{Call: _e.mock.On("GetChildTeams", ctx, teamName)}
+}
+
+func (_c *TeamsRepository_GetChildTeams_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_GetChildTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_GetChildTeams_Call) Return(_a0 []string, _a1 error) *TeamsRepository_GetChildTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_GetChildTeams_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *TeamsRepository_GetChildTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParentTeam provides a mock function with given fields: ctx, teamName
+func (_m *TeamsRepository) GetParentTeam(ctx context.Context, teamName string) (string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParentTeam")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_GetParentTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParentTeam'
+type TeamsRepository_GetParentTeam_Call struct {
+	*mock.Call
+}
+
+// GetParentTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamsRepository_Expecter) GetParentTeam(ctx interface{}, teamName interface{}) *TeamsRepository_GetParentTeam_Call {
+	return &TeamsRepository_GetParentTeam_Call{Call: _e.mock.On("GetParentTeam", ctx, teamName)}
+}
+
+func (_c *TeamsRepository_GetParentTeam_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_GetParentTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_GetParentTeam_Call) Return(_a0 string, _a1 error) *TeamsRepository_GetParentTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_GetParentTeam_Call) RunAndReturn(run func(context.Context, string) (string, error)) *TeamsRepository_GetParentTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeam provides a mock function with given fields: ctx, teamName
 func (_m *TeamsRepository) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
 	ret := _m.Called(ctx, teamName)
@@ -141,6 +361,64 @@ func (_c *TeamsRepository_GetTeam_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// GetTeamReviewsStats provides a mock function with given fields: ctx
+func (_m *TeamsRepository) GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamReviewsStats")
+	}
+
+	var r0 []*models.TeamReviewStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.TeamReviewStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.TeamReviewStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TeamReviewStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_GetTeamReviewsStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamReviewsStats'
+type TeamsRepository_GetTeamReviewsStats_Call struct {
+	*mock.Call
+}
+
+// GetTeamReviewsStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TeamsRepository_Expecter) GetTeamReviewsStats(ctx interface{}) *TeamsRepository_GetTeamReviewsStats_Call {
+	return &TeamsRepository_GetTeamReviewsStats_Call{Call: _e.mock.On("GetTeamReviewsStats", ctx)}
+}
+
+func (_c *TeamsRepository_GetTeamReviewsStats_Call) Run(run func(ctx context.Context)) *TeamsRepository_GetTeamReviewsStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_GetTeamReviewsStats_Call) Return(_a0 []*models.TeamReviewStats, _a1 error) *TeamsRepository_GetTeamReviewsStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_GetTeamReviewsStats_Call) RunAndReturn(run func(context.Context) ([]*models.TeamReviewStats, error)) *TeamsRepository_GetTeamReviewsStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserTeam provides a mock function with given fields: ctx, userID
 func (_m *TeamsRepository) GetUserTeam(ctx context.Context, userID string) (string, error) {
 	ret := _m.Called(ctx, userID)
@@ -198,22 +476,32 @@ func (_c *TeamsRepository_GetUserTeam_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// InsertTeam provides a mock function with given fields: ctx, teamName
-func (_m *TeamsRepository) InsertTeam(ctx context.Context, teamName string) error {
-	ret := _m.Called(ctx, teamName)
+// InsertTeam provides a mock function with given fields: ctx, teamName, parentTeamName
+func (_m *TeamsRepository) InsertTeam(ctx context.Context, teamName string, parentTeamName string) (bool, error) {
+	ret := _m.Called(ctx, teamName, parentTeamName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertTeam")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, teamName)
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, teamName, parentTeamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, teamName, parentTeamName)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, teamName, parentTeamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // TeamsRepository_InsertTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertTeam'
@@ -224,23 +512,225 @@ type TeamsRepository_InsertTeam_Call struct {
 // InsertTeam is a helper method to define mock.On call
 //   - ctx context.Context
 //   - teamName string
-func (_e *TeamsRepository_Expecter) InsertTeam(ctx interface{}, teamName interface{}) *TeamsRepository_InsertTeam_Call {
-	return &TeamsRepository_InsertTeam_Call{Call: _e.mock.On("InsertTeam", ctx, teamName)}
+//   - parentTeamName string
+func (_e *TeamsRepository_Expecter) InsertTeam(ctx interface{}, teamName interface{}, parentTeamName interface{}) *TeamsRepository_InsertTeam_Call {
+	return &TeamsRepository_InsertTeam_Call{Call: _e.mock.On("InsertTeam", ctx, teamName, parentTeamName)}
 }
 
-func (_c *TeamsRepository_InsertTeam_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_InsertTeam_Call {
+func (_c *TeamsRepository_InsertTeam_Call) Run(run func(ctx context.Context, teamName string, parentTeamName string)) *TeamsRepository_InsertTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_InsertTeam_Call) Return(_a0 bool, _a1 error) *TeamsRepository_InsertTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_InsertTeam_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *TeamsRepository_InsertTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveMembers provides a mock function with given fields: ctx, teamName, newTeamName
+func (_m *TeamsRepository) MoveMembers(ctx context.Context, teamName string, newTeamName string) error {
+	ret := _m.Called(ctx, teamName, newTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, newTeamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamsRepository_MoveMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveMembers'
+type TeamsRepository_MoveMembers_Call struct {
+	*mock.Call
+}
+
+// MoveMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - newTeamName string
+func (_e *TeamsRepository_Expecter) MoveMembers(ctx interface{}, teamName interface{}, newTeamName interface{}) *TeamsRepository_MoveMembers_Call {
+	return &TeamsRepository_MoveMembers_Call{Call: _e.mock.On("MoveMembers", ctx, teamName, newTeamName)}
+}
+
+func (_c *TeamsRepository_MoveMembers_Call) Run(run func(ctx context.Context, teamName string, newTeamName string)) *TeamsRepository_MoveMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_MoveMembers_Call) Return(_a0 error) *TeamsRepository_MoveMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamsRepository_MoveMembers_Call) RunAndReturn(run func(context.Context, string, string) error) *TeamsRepository_MoveMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTeam provides a mock function with given fields: ctx, teamName, newTeamName
+func (_m *TeamsRepository) RenameTeam(ctx context.Context, teamName string, newTeamName string) error {
+	ret := _m.Called(ctx, teamName, newTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, newTeamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamsRepository_RenameTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTeam'
+type TeamsRepository_RenameTeam_Call struct {
+	*mock.Call
+}
+
+// RenameTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - newTeamName string
+func (_e *TeamsRepository_Expecter) RenameTeam(ctx interface{}, teamName interface{}, newTeamName interface{}) *TeamsRepository_RenameTeam_Call {
+	return &TeamsRepository_RenameTeam_Call{Call: _e.mock.On("RenameTeam", ctx, teamName, newTeamName)}
+}
+
+func (_c *TeamsRepository_RenameTeam_Call) Run(run func(ctx context.Context, teamName string, newTeamName string)) *TeamsRepository_RenameTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TeamsRepository_InsertTeam_Call) Return(_a0 error) *TeamsRepository_InsertTeam_Call {
+func (_c *TeamsRepository_RenameTeam_Call) Return(_a0 error) *TeamsRepository_RenameTeam_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *TeamsRepository_InsertTeam_Call) RunAndReturn(run func(context.Context, string) error) *TeamsRepository_InsertTeam_Call {
+func (_c *TeamsRepository_RenameTeam_Call) RunAndReturn(run func(context.Context, string, string) error) *TeamsRepository_RenameTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetParentTeam provides a mock function with given fields: ctx, teamName, parentTeamName
+func (_m *TeamsRepository) SetParentTeam(ctx context.Context, teamName string, parentTeamName string) error {
+	ret := _m.Called(ctx, teamName, parentTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetParentTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, parentTeamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamsRepository_SetParentTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetParentTeam'
+type TeamsRepository_SetParentTeam_Call struct {
+	*mock.Call
+}
+
+// SetParentTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - parentTeamName string
+func (_e *TeamsRepository_Expecter) SetParentTeam(ctx interface{}, teamName interface{}, parentTeamName interface{}) *TeamsRepository_SetParentTeam_Call {
+	return &TeamsRepository_SetParentTeam_Call{Call: _e.mock.On("SetParentTeam", ctx, teamName, parentTeamName)}
+}
+
+func (_c *TeamsRepository_SetParentTeam_Call) Run(run func(ctx context.Context, teamName string, parentTeamName string)) *TeamsRepository_SetParentTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_SetParentTeam_Call) Return(_a0 error) *TeamsRepository_SetParentTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamsRepository_SetParentTeam_Call) RunAndReturn(run func(context.Context, string, string) error) *TeamsRepository_SetParentTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TeamExists provides a mock function with given fields: ctx, teamName
+func (_m *TeamsRepository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TeamExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_TeamExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TeamExists'
+type TeamsRepository_TeamExists_Call struct {
+	*mock.Call
+}
+
+// TeamExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamsRepository_Expecter) TeamExists(ctx interface{}, teamName interface{}) *TeamsRepository_TeamExists_Call {
+	return &TeamsRepository_TeamExists_Call{Call: _e.mock.On("TeamExists", ctx, teamName)}
+}
+
+func (_c *TeamsRepository_TeamExists_Call) Run(run func(ctx context.Context, teamName string)) *TeamsRepository_TeamExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_TeamExists_Call) Return(_a0 bool, _a1 error) *TeamsRepository_TeamExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_TeamExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *TeamsRepository_TeamExists_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -81,9 +81,9 @@ func (_c *UserReviewRepository_GetPRsByReviewer_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// GetReviewsStats provides a mock function with given fields: ctx
-func (_m *UserReviewRepository) GetReviewsStats(ctx context.Context) ([]*models.ReviewerStats, error) {
-	ret := _m.Called(ctx)
+// GetReviewsStats provides a mock function with given fields: ctx, q
+func (_m *UserReviewRepository) GetReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error) {
+	ret := _m.Called(ctx, q)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReviewsStats")
@@ -91,19 +91,19 @@ func (_m *UserReviewRepository) GetReviewsStats(ctx context.Context) ([]*models.
 
 	var r0 []*models.ReviewerStats
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.ReviewerStats, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error)); ok {
+		return rf(ctx, q)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*models.ReviewerStats); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReviewsStatsQuery) []*models.ReviewerStats); ok {
+		r0 = rf(ctx, q)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ReviewerStats)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ReviewsStatsQuery) error); ok {
+		r1 = rf(ctx, q)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,13 +118,14 @@ type UserReviewRepository_GetReviewsStats_Call struct {
 
 // GetReviewsStats is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *UserReviewRepository_Expecter) GetReviewsStats(ctx interface{}) *UserReviewRepository_GetReviewsStats_Call {
-	return &UserReviewRepository_GetReviewsStats_Call{Call: _e.mock.On("GetReviewsStats", ctx)}
+//   - q *models.ReviewsStatsQuery
+func (_e *UserReviewRepository_Expecter) GetReviewsStats(ctx interface{}, q interface{}) *UserReviewRepository_GetReviewsStats_Call {
+	return &UserReviewRepository_GetReviewsStats_Call{Call: _e.mock.On("GetReviewsStats", ctx, q)}
 }
 
-func (_c *UserReviewRepository_GetReviewsStats_Call) Run(run func(ctx context.Context)) *UserReviewRepository_GetReviewsStats_Call {
+func (_c *UserReviewRepository_GetReviewsStats_Call) Run(run func(ctx context.Context, q *models.ReviewsStatsQuery)) *UserReviewRepository_GetReviewsStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*models.ReviewsStatsQuery))
 	})
 	return _c
 }
@@ -134,7 +135,123 @@ func (_c *UserReviewRepository_GetReviewsStats_Call) Return(_a0 []*models.Review
 	return _c
 }
 
-func (_c *UserReviewRepository_GetReviewsStats_Call) RunAndReturn(run func(context.Context) ([]*models.ReviewerStats, error)) *UserReviewRepository_GetReviewsStats_Call {
+func (_c *UserReviewRepository_GetReviewsStats_Call) RunAndReturn(run func(context.Context, *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error)) *UserReviewRepository_GetReviewsStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamPickupStats provides a mock function with given fields: ctx
+func (_m *UserReviewRepository) GetTeamPickupStats(ctx context.Context) ([]*models.TeamPickupStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamPickupStats")
+	}
+
+	var r0 []*models.TeamPickupStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.TeamPickupStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.TeamPickupStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TeamPickupStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserReviewRepository_GetTeamPickupStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamPickupStats'
+type UserReviewRepository_GetTeamPickupStats_Call struct {
+	*mock.Call
+}
+
+// GetTeamPickupStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserReviewRepository_Expecter) GetTeamPickupStats(ctx interface{}) *UserReviewRepository_GetTeamPickupStats_Call {
+	return &UserReviewRepository_GetTeamPickupStats_Call{Call: _e.mock.On("GetTeamPickupStats", ctx)}
+}
+
+func (_c *UserReviewRepository_GetTeamPickupStats_Call) Run(run func(ctx context.Context)) *UserReviewRepository_GetTeamPickupStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserReviewRepository_GetTeamPickupStats_Call) Return(_a0 []*models.TeamPickupStats, _a1 error) *UserReviewRepository_GetTeamPickupStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserReviewRepository_GetTeamPickupStats_Call) RunAndReturn(run func(context.Context) ([]*models.TeamPickupStats, error)) *UserReviewRepository_GetTeamPickupStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPickupStats provides a mock function with given fields: ctx
+func (_m *UserReviewRepository) GetUserPickupStats(ctx context.Context) ([]*models.UserPickupStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPickupStats")
+	}
+
+	var r0 []*models.UserPickupStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.UserPickupStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.UserPickupStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserPickupStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserReviewRepository_GetUserPickupStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPickupStats'
+type UserReviewRepository_GetUserPickupStats_Call struct {
+	*mock.Call
+}
+
+// GetUserPickupStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserReviewRepository_Expecter) GetUserPickupStats(ctx interface{}) *UserReviewRepository_GetUserPickupStats_Call {
+	return &UserReviewRepository_GetUserPickupStats_Call{Call: _e.mock.On("GetUserPickupStats", ctx)}
+}
+
+func (_c *UserReviewRepository_GetUserPickupStats_Call) Run(run func(ctx context.Context)) *UserReviewRepository_GetUserPickupStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserReviewRepository_GetUserPickupStats_Call) Return(_a0 []*models.UserPickupStats, _a1 error) *UserReviewRepository_GetUserPickupStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserReviewRepository_GetUserPickupStats_Call) RunAndReturn(run func(context.Context) ([]*models.UserPickupStats, error)) *UserReviewRepository_GetUserPickupStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserTeamsRepository is an autogenerated mock type for the UserTeamsRepository type
+type UserTeamsRepository struct {
+	mock.Mock
+}
+
+type UserTeamsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserTeamsRepository) EXPECT() *UserTeamsRepository_Expecter {
+	return &UserTeamsRepository_Expecter{mock: &_m.Mock}
+}
+
+// TeamExists provides a mock function with given fields: ctx, teamName
+func (_m *UserTeamsRepository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TeamExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserTeamsRepository_TeamExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TeamExists'
+type UserTeamsRepository_TeamExists_Call struct {
+	*mock.Call
+}
+
+// TeamExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *UserTeamsRepository_Expecter) TeamExists(ctx interface{}, teamName interface{}) *UserTeamsRepository_TeamExists_Call {
+	return &UserTeamsRepository_TeamExists_Call{Call: _e.mock.On("TeamExists", ctx, teamName)}
+}
+
+func (_c *UserTeamsRepository_TeamExists_Call) Run(run func(ctx context.Context, teamName string)) *UserTeamsRepository_TeamExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserTeamsRepository_TeamExists_Call) Return(_a0 bool, _a1 error) *UserTeamsRepository_TeamExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserTeamsRepository_TeamExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *UserTeamsRepository_TeamExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserTeamsRepository creates a new instance of UserTeamsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserTeamsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserTeamsRepository {
+	mock := &UserTeamsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 	models "pull-request-service/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UsersRepository is an autogenerated mock type for the UsersRepository type
@@ -22,6 +24,53 @@ func (_m *UsersRepository) EXPECT() *UsersRepository_Expecter {
 	return &UsersRepository_Expecter{mock: &_m.Mock}
 }
 
+// CancelReactivation provides a mock function with given fields: ctx, userID
+func (_m *UsersRepository) CancelReactivation(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelReactivation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_CancelReactivation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelReactivation'
+type UsersRepository_CancelReactivation_Call struct {
+	*mock.Call
+}
+
+// CancelReactivation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UsersRepository_Expecter) CancelReactivation(ctx interface{}, userID interface{}) *UsersRepository_CancelReactivation_Call {
+	return &UsersRepository_CancelReactivation_Call{Call: _e.mock.On("CancelReactivation", ctx, userID)}
+}
+
+func (_c *UsersRepository_CancelReactivation_Call) Run(run func(ctx context.Context, userID string)) *UsersRepository_CancelReactivation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_CancelReactivation_Call) Return(_a0 error) *UsersRepository_CancelReactivation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_CancelReactivation_Call) RunAndReturn(run func(context.Context, string) error) *UsersRepository_CancelReactivation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function with given fields: ctx, userID
 func (_m *UsersRepository) GetUser(ctx context.Context, userID string) (*models.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -81,17 +130,340 @@ func (_c *UsersRepository_GetUser_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
-// UpdateUserActiveStatus provides a mock function with given fields: ctx, userID, isActive
-func (_m *UsersRepository) UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool) error {
-	ret := _m.Called(ctx, userID, isActive)
+// GetUserMemberships provides a mock function with given fields: ctx, userID
+func (_m *UsersRepository) GetUserMemberships(ctx context.Context, userID string) ([]models.TeamMembership, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserMemberships")
+	}
+
+	var r0 []models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.TeamMembership, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.TeamMembership); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsersRepository_GetUserMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserMemberships'
+type UsersRepository_GetUserMemberships_Call struct {
+	*mock.Call
+}
+
+// GetUserMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UsersRepository_Expecter) GetUserMemberships(ctx interface{}, userID interface{}) *UsersRepository_GetUserMemberships_Call {
+	return &UsersRepository_GetUserMemberships_Call{Call: _e.mock.On("GetUserMemberships", ctx, userID)}
+}
+
+func (_c *UsersRepository_GetUserMemberships_Call) Run(run func(ctx context.Context, userID string)) *UsersRepository_GetUserMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_GetUserMemberships_Call) Return(_a0 []models.TeamMembership, _a1 error) *UsersRepository_GetUserMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsersRepository_GetUserMemberships_Call) RunAndReturn(run func(context.Context, string) ([]models.TeamMembership, error)) *UsersRepository_GetUserMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OffboardUser provides a mock function with given fields: ctx, userID, pseudonym
+func (_m *UsersRepository) OffboardUser(ctx context.Context, userID string, pseudonym string) error {
+	ret := _m.Called(ctx, userID, pseudonym)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OffboardUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, pseudonym)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_OffboardUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OffboardUser'
+type UsersRepository_OffboardUser_Call struct {
+	*mock.Call
+}
+
+// OffboardUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pseudonym string
+func (_e *UsersRepository_Expecter) OffboardUser(ctx interface{}, userID interface{}, pseudonym interface{}) *UsersRepository_OffboardUser_Call {
+	return &UsersRepository_OffboardUser_Call{Call: _e.mock.On("OffboardUser", ctx, userID, pseudonym)}
+}
+
+func (_c *UsersRepository_OffboardUser_Call) Run(run func(ctx context.Context, userID string, pseudonym string)) *UsersRepository_OffboardUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_OffboardUser_Call) Return(_a0 error) *UsersRepository_OffboardUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_OffboardUser_Call) RunAndReturn(run func(context.Context, string, string) error) *UsersRepository_OffboardUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReactivateExpired provides a mock function with given fields: ctx, now
+func (_m *UsersRepository) ReactivateExpired(ctx context.Context, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactivateExpired")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsersRepository_ReactivateExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReactivateExpired'
+type UsersRepository_ReactivateExpired_Call struct {
+	*mock.Call
+}
+
+// ReactivateExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *UsersRepository_Expecter) ReactivateExpired(ctx interface{}, now interface{}) *UsersRepository_ReactivateExpired_Call {
+	return &UsersRepository_ReactivateExpired_Call{Call: _e.mock.On("ReactivateExpired", ctx, now)}
+}
+
+func (_c *UsersRepository_ReactivateExpired_Call) Run(run func(ctx context.Context, now time.Time)) *UsersRepository_ReactivateExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_ReactivateExpired_Call) Return(_a0 []string, _a1 error) *UsersRepository_ReactivateExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsersRepository_ReactivateExpired_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *UsersRepository_ReactivateExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTeamChange provides a mock function with given fields: ctx, userID, fromTeam, toTeam
+func (_m *UsersRepository) RecordTeamChange(ctx context.Context, userID string, fromTeam string, toTeam string) error {
+	ret := _m.Called(ctx, userID, fromTeam, toTeam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTeamChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, fromTeam, toTeam)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_RecordTeamChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTeamChange'
+type UsersRepository_RecordTeamChange_Call struct {
+	*mock.Call
+}
+
+// RecordTeamChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - fromTeam string
+//   - toTeam string
+func (_e *UsersRepository_Expecter) RecordTeamChange(ctx interface{}, userID interface{}, fromTeam interface{}, toTeam interface{}) *UsersRepository_RecordTeamChange_Call {
+	return &UsersRepository_RecordTeamChange_Call{Call: _e.mock.On("RecordTeamChange", ctx, userID, fromTeam, toTeam)}
+}
+
+func (_c *UsersRepository_RecordTeamChange_Call) Run(run func(ctx context.Context, userID string, fromTeam string, toTeam string)) *UsersRepository_RecordTeamChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_RecordTeamChange_Call) Return(_a0 error) *UsersRepository_RecordTeamChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_RecordTeamChange_Call) RunAndReturn(run func(context.Context, string, string, string) error) *UsersRepository_RecordTeamChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMemberships provides a mock function with given fields: ctx, userID, memberships
+func (_m *UsersRepository) ReplaceMemberships(ctx context.Context, userID string, memberships []models.TeamMembership) error {
+	ret := _m.Called(ctx, userID, memberships)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMemberships")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.TeamMembership) error); ok {
+		r0 = rf(ctx, userID, memberships)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_ReplaceMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMemberships'
+type UsersRepository_ReplaceMemberships_Call struct {
+	*mock.Call
+}
+
+// ReplaceMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - memberships []models.TeamMembership
+func (_e *UsersRepository_Expecter) ReplaceMemberships(ctx interface{}, userID interface{}, memberships interface{}) *UsersRepository_ReplaceMemberships_Call {
+	return &UsersRepository_ReplaceMemberships_Call{Call: _e.mock.On("ReplaceMemberships", ctx, userID, memberships)}
+}
+
+func (_c *UsersRepository_ReplaceMemberships_Call) Run(run func(ctx context.Context, userID string, memberships []models.TeamMembership)) *UsersRepository_ReplaceMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]models.TeamMembership))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_ReplaceMemberships_Call) Return(_a0 error) *UsersRepository_ReplaceMemberships_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_ReplaceMemberships_Call) RunAndReturn(run func(context.Context, string, []models.TeamMembership) error) *UsersRepository_ReplaceMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsers provides a mock function with given fields: ctx, q, limit
+func (_m *UsersRepository) SearchUsers(ctx context.Context, q *models.ListUsersQuery, limit int) ([]*models.User, error) {
+	ret := _m.Called(ctx, q, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListUsersQuery, int) ([]*models.User, error)); ok {
+		return rf(ctx, q, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListUsersQuery, int) []*models.User); ok {
+		r0 = rf(ctx, q, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ListUsersQuery, int) error); ok {
+		r1 = rf(ctx, q, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsersRepository_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type UsersRepository_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *models.ListUsersQuery
+//   - limit int
+func (_e *UsersRepository_Expecter) SearchUsers(ctx interface{}, q interface{}, limit interface{}) *UsersRepository_SearchUsers_Call {
+	return &UsersRepository_SearchUsers_Call{Call: _e.mock.On("SearchUsers", ctx, q, limit)}
+}
+
+func (_c *UsersRepository_SearchUsers_Call) Run(run func(ctx context.Context, q *models.ListUsersQuery, limit int)) *UsersRepository_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ListUsersQuery), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_SearchUsers_Call) Return(_a0 []*models.User, _a1 error) *UsersRepository_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsersRepository_SearchUsers_Call) RunAndReturn(run func(context.Context, *models.ListUsersQuery, int) ([]*models.User, error)) *UsersRepository_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserActiveStatus provides a mock function with given fields: ctx, userID, isActive, until
+func (_m *UsersRepository) UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) error {
+	ret := _m.Called(ctx, userID, isActive, until)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUserActiveStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
-		r0 = rf(ctx, userID, isActive)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, *time.Time) error); ok {
+		r0 = rf(ctx, userID, isActive, until)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,13 +480,14 @@ type UsersRepository_UpdateUserActiveStatus_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - isActive bool
-func (_e *UsersRepository_Expecter) UpdateUserActiveStatus(ctx interface{}, userID interface{}, isActive interface{}) *UsersRepository_UpdateUserActiveStatus_Call {
-	return &UsersRepository_UpdateUserActiveStatus_Call{Call: _e.mock.On("UpdateUserActiveStatus", ctx, userID, isActive)}
+//   - until *time.Time
+func (_e *UsersRepository_Expecter) UpdateUserActiveStatus(ctx interface{}, userID interface{}, isActive interface{}, until interface{}) *UsersRepository_UpdateUserActiveStatus_Call {
+	return &UsersRepository_UpdateUserActiveStatus_Call{Call: _e.mock.On("UpdateUserActiveStatus", ctx, userID, isActive, until)}
 }
 
-func (_c *UsersRepository_UpdateUserActiveStatus_Call) Run(run func(ctx context.Context, userID string, isActive bool)) *UsersRepository_UpdateUserActiveStatus_Call {
+func (_c *UsersRepository_UpdateUserActiveStatus_Call) Run(run func(ctx context.Context, userID string, isActive bool, until *time.Time)) *UsersRepository_UpdateUserActiveStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(*time.Time))
 	})
 	return _c
 }
@@ -124,7 +497,151 @@ func (_c *UsersRepository_UpdateUserActiveStatus_Call) Return(_a0 error) *UsersR
 	return _c
 }
 
-func (_c *UsersRepository_UpdateUserActiveStatus_Call) RunAndReturn(run func(context.Context, string, bool) error) *UsersRepository_UpdateUserActiveStatus_Call {
+func (_c *UsersRepository_UpdateUserActiveStatus_Call) RunAndReturn(run func(context.Context, string, bool, *time.Time) error) *UsersRepository_UpdateUserActiveStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserProfile provides a mock function with given fields: ctx, userID, p
+func (_m *UsersRepository) UpdateUserProfile(ctx context.Context, userID string, p *models.UserProfile) error {
+	ret := _m.Called(ctx, userID, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UserProfile) error); ok {
+		r0 = rf(ctx, userID, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_UpdateUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserProfile'
+type UsersRepository_UpdateUserProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateUserProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - p *models.UserProfile
+func (_e *UsersRepository_Expecter) UpdateUserProfile(ctx interface{}, userID interface{}, p interface{}) *UsersRepository_UpdateUserProfile_Call {
+	return &UsersRepository_UpdateUserProfile_Call{Call: _e.mock.On("UpdateUserProfile", ctx, userID, p)}
+}
+
+func (_c *UsersRepository_UpdateUserProfile_Call) Run(run func(ctx context.Context, userID string, p *models.UserProfile)) *UsersRepository_UpdateUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UserProfile))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserProfile_Call) Return(_a0 error) *UsersRepository_UpdateUserProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserProfile_Call) RunAndReturn(run func(context.Context, string, *models.UserProfile) error) *UsersRepository_UpdateUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, userID, role
+func (_m *UsersRepository) UpdateUserRole(ctx context.Context, userID string, role models.UserRole) error {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UserRole) error); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type UsersRepository_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - role models.UserRole
+func (_e *UsersRepository_Expecter) UpdateUserRole(ctx interface{}, userID interface{}, role interface{}) *UsersRepository_UpdateUserRole_Call {
+	return &UsersRepository_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", ctx, userID, role)}
+}
+
+func (_c *UsersRepository_UpdateUserRole_Call) Run(run func(ctx context.Context, userID string, role models.UserRole)) *UsersRepository_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.UserRole))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserRole_Call) Return(_a0 error) *UsersRepository_UpdateUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserRole_Call) RunAndReturn(run func(context.Context, string, models.UserRole) error) *UsersRepository_UpdateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserTeam provides a mock function with given fields: ctx, userID, teamName
+func (_m *UsersRepository) UpdateUserTeam(ctx context.Context, userID string, teamName string) error {
+	ret := _m.Called(ctx, userID, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsersRepository_UpdateUserTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserTeam'
+type UsersRepository_UpdateUserTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateUserTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - teamName string
+func (_e *UsersRepository_Expecter) UpdateUserTeam(ctx interface{}, userID interface{}, teamName interface{}) *UsersRepository_UpdateUserTeam_Call {
+	return &UsersRepository_UpdateUserTeam_Call{Call: _e.mock.On("UpdateUserTeam", ctx, userID, teamName)}
+}
+
+func (_c *UsersRepository_UpdateUserTeam_Call) Run(run func(ctx context.Context, userID string, teamName string)) *UsersRepository_UpdateUserTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserTeam_Call) Return(_a0 error) *UsersRepository_UpdateUserTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsersRepository_UpdateUserTeam_Call) RunAndReturn(run func(context.Context, string, string) error) *UsersRepository_UpdateUserTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
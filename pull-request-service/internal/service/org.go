@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"pull-request-service/internal/models"
+	"pull-request-service/internal/orgchart"
+)
+
+type OrgTeamsRepository interface {
+	ListTeams(ctx context.Context) ([]*models.Team, error)
+	InsertTeam(ctx context.Context, teamName, parentTeamName string) (bool, error)
+	SetParentTeam(ctx context.Context, teamName, parentTeamName string) error
+}
+
+type OrgUsersRepository interface {
+	ListUsers(ctx context.Context) ([]*models.User, error)
+	UpsertUser(ctx context.Context, userID, username, teamName string, isActive bool) error
+	UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) error
+	DetachUser(ctx context.Context, userID string) error
+}
+
+type OrgReviewRepository interface {
+	GetPRsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
+}
+
+type OrgService struct {
+	teamsRepo  OrgTeamsRepository
+	usersRepo  OrgUsersRepository
+	reviewRepo OrgReviewRepository
+	reassigner ReviewReassigner
+	txMgr      TransactionManager
+}
+
+func NewOrgService(
+	t OrgTeamsRepository,
+	u OrgUsersRepository,
+	r OrgReviewRepository,
+	reassigner ReviewReassigner,
+	txMgr TransactionManager,
+) *OrgService {
+	return &OrgService{
+		teamsRepo:  t,
+		usersRepo:  u,
+		reviewRepo: r,
+		reassigner: reassigner,
+		txMgr:      txMgr,
+	}
+}
+
+// Import computes the difference between the org chart document and the current teams/users
+// and, unless dryRun is set, applies it in a single transaction. Users that have a team but are
+// missing from the document are detached and deactivated; teams are never deleted. Open reviews of
+// moved, deactivated and removed users are listed in the diff and reassigned within their old
+// team before the changes are applied.
+func (s *OrgService) Import(ctx context.Context, r io.Reader, format models.OrgChartFormat, dryRun bool) (*models.OrgDiff, error) {
+	teams, err := orgchart.Parse(r, format)
+	if err != nil {
+		return nil, fmt.Errorf("error: code: INVALID_ORG_CHART, message: %s", err.Error())
+	}
+
+	diff := &models.OrgDiff{
+		AddedTeams:  []string{},
+		Added:       []models.OrgUserChange{},
+		Moved:       []models.OrgUserChange{},
+		Deactivated: []models.OrgUserChange{},
+		Reactivated: []models.OrgUserChange{},
+		Removed:     []models.OrgUserChange{},
+		OpenReviews: []models.OrgOpenReview{},
+	}
+
+	err = s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		existingTeams, err := s.teamsRepo.ListTeams(txCtx)
+		if err != nil {
+			return fmt.Errorf("listing teams: %w", err)
+		}
+
+		parents := make(map[string]string, len(existingTeams)+len(teams))
+		for _, t := range existingTeams {
+			parents[t.TeamName] = t.ParentTeamName
+		}
+		for _, t := range teams {
+			if _, ok := parents[t.TeamName]; !ok {
+				diff.AddedTeams = append(diff.AddedTeams, t.TeamName)
+			}
+		}
+		for _, t := range teams {
+			parents[t.TeamName] = t.ParentTeamName
+		}
+		if err := checkHierarchy(parents); err != nil {
+			return err
+		}
+
+		users, err := s.usersRepo.ListUsers(txCtx)
+		if err != nil {
+			return fmt.Errorf("listing users: %w", err)
+		}
+
+		current := make(map[string]*models.User, len(users))
+		for _, u := range users {
+			current[u.UserID] = u
+		}
+
+		listed := make(map[string]bool)
+		for _, t := range teams {
+			for _, m := range t.Members {
+				listed[m.UserID] = true
+				change := models.OrgUserChange{UserID: m.UserID, Username: m.Username, ToTeam: t.TeamName}
+
+				u, ok := current[m.UserID]
+				if !ok {
+					diff.Added = append(diff.Added, change)
+					continue
+				}
+				if u.OffboardedAt != nil {
+					return fmt.Errorf("error: code: INVALID_ORG_CHART, message: user %s is offboarded", m.UserID)
+				}
+
+				change.FromTeam = u.TeamName
+				if u.TeamName != t.TeamName {
+					diff.Moved = append(diff.Moved, change)
+				}
+				if u.IsActive && !m.IsActive {
+					diff.Deactivated = append(diff.Deactivated, change)
+				}
+				if !u.IsActive && m.IsActive {
+					diff.Reactivated = append(diff.Reactivated, change)
+				}
+			}
+		}
+
+		for _, u := range users {
+			if u.TeamName != "" && !listed[u.UserID] {
+				diff.Removed = append(diff.Removed, models.OrgUserChange{UserID: u.UserID, Username: u.Username, FromTeam: u.TeamName})
+			}
+		}
+
+		leaving := make(map[string]bool)
+		for _, changes := range [][]models.OrgUserChange{diff.Deactivated, diff.Removed, diff.Moved} {
+			for _, change := range changes {
+				if leaving[change.UserID] {
+					continue
+				}
+				leaving[change.UserID] = true
+
+				prs, err := s.reviewRepo.GetPRsByReviewer(txCtx, change.UserID)
+				if err != nil {
+					return fmt.Errorf("getting reviews of user %s: %w", change.UserID, err)
+				}
+				for _, pr := range prs {
+					if pr.Status == models.StatusOpen {
+						diff.OpenReviews = append(diff.OpenReviews, models.OrgOpenReview{UserID: change.UserID, PullRequestID: pr.PullRequestID})
+					}
+				}
+			}
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		// Departing users are switched off first so that they are not picked as each other's replacements.
+		for _, changes := range [][]models.OrgUserChange{diff.Deactivated, diff.Removed} {
+			for _, change := range changes {
+				if err := s.usersRepo.UpdateUserActiveStatus(txCtx, change.UserID, false, nil); err != nil {
+					return fmt.Errorf("deactivating user %s: %w", change.UserID, err)
+				}
+			}
+		}
+		for i := range diff.OpenReviews {
+			review := &diff.OpenReviews[i]
+			_, replacedBy, err := s.reassigner.ReassignReviewer(txCtx, review.PullRequestID, review.UserID, 0)
+			if err != nil {
+				return fmt.Errorf("reassigning review %s: %w", review.PullRequestID, err)
+			}
+			review.ReplacedBy = replacedBy
+		}
+
+		for _, t := range teams {
+			if _, err := s.teamsRepo.InsertTeam(txCtx, t.TeamName, ""); err != nil {
+				return fmt.Errorf("inserting team %s: %w", t.TeamName, err)
+			}
+		}
+		for _, t := range teams {
+			if err := s.teamsRepo.SetParentTeam(txCtx, t.TeamName, t.ParentTeamName); err != nil {
+				return fmt.Errorf("setting parent of team %s: %w", t.TeamName, err)
+			}
+		}
+
+		for _, t := range teams {
+			for _, m := range t.Members {
+				if err := s.usersRepo.UpsertUser(txCtx, m.UserID, m.Username, t.TeamName, m.IsActive); err != nil {
+					return fmt.Errorf("upserting user %s: %w", m.UserID, err)
+				}
+			}
+		}
+
+		for _, change := range diff.Removed {
+			if err := s.usersRepo.DetachUser(txCtx, change.UserID); err != nil {
+				return fmt.Errorf("detaching user %s: %w", change.UserID, err)
+			}
+		}
+
+		diff.Applied = true
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "INVALID_PARENT":
+			return nil, fmt.Errorf("error: code: INVALID_ORG_CHART, message: parent team not found or hierarchy has a cycle")
+		default:
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
+
+// checkHierarchy makes sure every parent is a known team and following parents never loops.
+func checkHierarchy(parents map[string]string) error {
+	for team := range parents {
+		visited := make(map[string]bool)
+		for t := team; t != ""; t = parents[t] {
+			if _, ok := parents[t]; !ok || visited[t] {
+				return errors.New("INVALID_PARENT")
+			}
+			visited[t] = true
+		}
+	}
+
+	return nil
+}
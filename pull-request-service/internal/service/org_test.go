@@ -0,0 +1,225 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pull-request-service/internal/models"
+	"pull-request-service/internal/service"
+	"pull-request-service/internal/service/mocks"
+)
+
+const orgChartYAML = `
+teams:
+  - team_name: engineering
+    members:
+      - user_id: u1
+        username: Alice
+  - team_name: backend
+    parent_team_name: engineering
+    members:
+      - user_id: u2
+        username: Bob
+      - user_id: u3
+        username: Carol
+        is_active: false
+      - user_id: u4
+        username: Dave
+`
+
+const orgChartCSV = `team_name,parent_team_name,user_id,username,is_active
+engineering,,u1,Alice,
+backend,engineering,u2,Bob,true
+backend,engineering,u3,Carol,false
+backend,engineering,u4,Dave,
+`
+
+func TestOrgService_Import(t *testing.T) {
+	tests := []struct {
+		name   string
+		doc    string
+		format models.OrgChartFormat
+		dryRun bool
+	}{
+		{name: "yaml dry run", doc: orgChartYAML, format: models.OrgChartYAML, dryRun: true},
+		{name: "yaml apply", doc: orgChartYAML, format: models.OrgChartYAML},
+		{name: "csv apply", doc: orgChartCSV, format: models.OrgChartCSV},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamsRepo := mocks.NewOrgTeamsRepository(t)
+			usersRepo := mocks.NewOrgUsersRepository(t)
+			reviewRepo := mocks.NewOrgReviewRepository(t)
+			reassigner := mocks.NewReviewReassigner(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			teamsRepo.EXPECT().ListTeams(mock.Anything).Return([]*models.Team{{TeamName: "engineering"}}, nil)
+			usersRepo.EXPECT().ListUsers(mock.Anything).Return([]*models.User{
+				{UserID: "u1", Username: "Alice", TeamName: "engineering", IsActive: true},
+				{UserID: "u2", Username: "Bob", TeamName: "engineering", IsActive: true},
+				{UserID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+				{UserID: "u5", Username: "Eve", TeamName: "engineering", IsActive: true},
+				{UserID: "u6", Username: "Frank", IsActive: false},
+			}, nil)
+			reviewRepo.EXPECT().GetPRsByReviewer(mock.Anything, "u3").Return([]*models.PullRequestShort{
+				{PullRequestID: "pr1", Status: models.StatusOpen},
+				{PullRequestID: "pr2", Status: models.StatusMerged},
+			}, nil)
+			reviewRepo.EXPECT().GetPRsByReviewer(mock.Anything, "u5").Return([]*models.PullRequestShort{
+				{PullRequestID: "pr3", Status: models.StatusOpen},
+			}, nil)
+			reviewRepo.EXPECT().GetPRsByReviewer(mock.Anything, "u2").Return(nil, nil)
+
+			wantReviews := []models.OrgOpenReview{
+				{UserID: "u3", PullRequestID: "pr1"},
+				{UserID: "u5", PullRequestID: "pr3"},
+			}
+
+			if !tt.dryRun {
+				usersRepo.EXPECT().UpdateUserActiveStatus(mock.Anything, "u3", false, (*time.Time)(nil)).Return(nil)
+				usersRepo.EXPECT().UpdateUserActiveStatus(mock.Anything, "u5", false, (*time.Time)(nil)).Return(nil)
+				reassigner.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u3", 0).Return(&models.PullRequest{}, "u1", nil)
+				reassigner.EXPECT().ReassignReviewer(mock.Anything, "pr3", "u5", 0).Return(&models.PullRequest{}, "u1", nil)
+				wantReviews[0].ReplacedBy = "u1"
+				wantReviews[1].ReplacedBy = "u1"
+
+				teamsRepo.EXPECT().InsertTeam(mock.Anything, "engineering", "").Return(false, nil)
+				teamsRepo.EXPECT().InsertTeam(mock.Anything, "backend", "").Return(true, nil)
+				teamsRepo.EXPECT().SetParentTeam(mock.Anything, "engineering", "").Return(nil)
+				teamsRepo.EXPECT().SetParentTeam(mock.Anything, "backend", "engineering").Return(nil)
+				usersRepo.EXPECT().UpsertUser(mock.Anything, "u1", "Alice", "engineering", true).Return(nil)
+				usersRepo.EXPECT().UpsertUser(mock.Anything, "u2", "Bob", "backend", true).Return(nil)
+				usersRepo.EXPECT().UpsertUser(mock.Anything, "u3", "Carol", "backend", false).Return(nil)
+				usersRepo.EXPECT().UpsertUser(mock.Anything, "u4", "Dave", "backend", true).Return(nil)
+				usersRepo.EXPECT().DetachUser(mock.Anything, "u5").Return(nil)
+			}
+
+			svc := service.NewOrgService(teamsRepo, usersRepo, reviewRepo, reassigner, txMgr)
+
+			diff, err := svc.Import(context.Background(), strings.NewReader(tt.doc), tt.format, tt.dryRun)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"backend"}, diff.AddedTeams)
+			assert.Equal(t, []models.OrgUserChange{{UserID: "u4", Username: "Dave", ToTeam: "backend"}}, diff.Added)
+			assert.Equal(t, []models.OrgUserChange{{UserID: "u2", Username: "Bob", FromTeam: "engineering", ToTeam: "backend"}}, diff.Moved)
+			assert.Equal(t, []models.OrgUserChange{{UserID: "u3", Username: "Carol", FromTeam: "backend", ToTeam: "backend"}}, diff.Deactivated)
+			assert.Empty(t, diff.Reactivated)
+			assert.Equal(t, []models.OrgUserChange{{UserID: "u5", Username: "Eve", FromTeam: "engineering"}}, diff.Removed)
+			assert.Equal(t, wantReviews, diff.OpenReviews)
+			assert.Equal(t, !tt.dryRun, diff.Applied)
+		})
+	}
+}
+
+func TestOrgService_Import_NoCandidate(t *testing.T) {
+	teamsRepo := mocks.NewOrgTeamsRepository(t)
+	usersRepo := mocks.NewOrgUsersRepository(t)
+	reviewRepo := mocks.NewOrgReviewRepository(t)
+	reassigner := mocks.NewReviewReassigner(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+	teamsRepo.EXPECT().ListTeams(mock.Anything).Return([]*models.Team{{TeamName: "backend"}}, nil)
+	usersRepo.EXPECT().ListUsers(mock.Anything).Return([]*models.User{
+		{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}, nil)
+	reviewRepo.EXPECT().GetPRsByReviewer(mock.Anything, "u2").Return([]*models.PullRequestShort{
+		{PullRequestID: "pr1", Status: models.StatusOpen},
+	}, nil)
+	usersRepo.EXPECT().UpdateUserActiveStatus(mock.Anything, "u2", false, (*time.Time)(nil)).Return(nil)
+	reassigner.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u2", 0).
+		Return(nil, "", errors.New("error: code: NO_CANDIDATE, message: no active replacement candidate in team"))
+
+	svc := service.NewOrgService(teamsRepo, usersRepo, reviewRepo, reassigner, txMgr)
+
+	doc := `
+teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: Alice
+      - user_id: u2
+        username: Bob
+        is_active: false
+`
+	diff, err := svc.Import(context.Background(), strings.NewReader(doc), models.OrgChartYAML, false)
+	require.ErrorContains(t, err, "NO_CANDIDATE")
+	require.Nil(t, diff)
+}
+
+func TestOrgService_Import_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		setup func(teamsRepo *mocks.OrgTeamsRepository, txMgr *mocks.TransactionManager)
+	}{
+		{
+			name: "malformed yaml",
+			doc:  "teams: [",
+		},
+		{
+			name: "user listed twice",
+			doc: `
+teams:
+  - team_name: a
+    members: [{user_id: u1, username: Alice}]
+  - team_name: b
+    members: [{user_id: u1, username: Alice}]
+`,
+		},
+		{
+			name: "unknown parent",
+			doc: `
+teams:
+  - team_name: a
+    parent_team_name: missing
+`,
+			setup: func(teamsRepo *mocks.OrgTeamsRepository, txMgr *mocks.TransactionManager) {
+				expectTx(txMgr)
+				teamsRepo.EXPECT().ListTeams(mock.Anything).Return(nil, nil)
+			},
+		},
+		{
+			name: "parent cycle",
+			doc: `
+teams:
+  - team_name: a
+    parent_team_name: b
+  - team_name: b
+    parent_team_name: a
+`,
+			setup: func(teamsRepo *mocks.OrgTeamsRepository, txMgr *mocks.TransactionManager) {
+				expectTx(txMgr)
+				teamsRepo.EXPECT().ListTeams(mock.Anything).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamsRepo := mocks.NewOrgTeamsRepository(t)
+			usersRepo := mocks.NewOrgUsersRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			if tt.setup != nil {
+				tt.setup(teamsRepo, txMgr)
+			}
+
+			svc := service.NewOrgService(teamsRepo, usersRepo, nil, nil, txMgr)
+
+			diff, err := svc.Import(context.Background(), strings.NewReader(tt.doc), models.OrgChartYAML, false)
+			require.ErrorContains(t, err, "INVALID_ORG_CHART")
+			require.Nil(t, diff)
+		})
+	}
+}
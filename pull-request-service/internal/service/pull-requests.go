@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"slices"
-	"time"
+	"sort"
 
 	"pull-request-service/internal/models"
 )
@@ -13,56 +14,209 @@ import (
 type PullRequestRepository interface {
 	CreatePR(ctx context.Context, pr *models.PullRequest) error
 	GetPR(ctx context.Context, prID string) (*models.PullRequest, error)
+	GetPRForUpdate(ctx context.Context, prID string) (*models.PullRequest, error)
+	BumpVersion(ctx context.Context, prID string) error
+	PRExists(ctx context.Context, prID string) (bool, error)
 	MergePR(ctx context.Context, prID string) error
+	SoftDeletePR(ctx context.Context, prID string) error
+	DeletePR(ctx context.Context, prID string) error
 }
 
 type ReviewRepository interface {
 	AddReviewer(ctx context.Context, prID, userID string) error
 	RemoveReviewer(ctx context.Context, prID, userID string) error
+	RemoveAllReviewers(ctx context.Context, prID string) error
+	StartReview(ctx context.Context, prID, userID string) (*models.ReviewAssignment, error)
 	GetPRReviewers(ctx context.Context, prID string) ([]string, error)
 	GetPRsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
 }
 
 type TeamInfoRepository interface {
-	GetUserTeam(ctx context.Context, userID string) (string, error)
-	GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]string, error)
+	GetUserTeams(ctx context.Context, userID string) ([]string, error)
+	GetActiveTeamCandidates(ctx context.Context, teamName string, excludeUserID string) ([]*models.ReviewCandidate, error)
+	GetParentTeam(ctx context.Context, teamName string) (string, error)
+	GetChildTeams(ctx context.Context, teamName string) ([]string, error)
 }
 
+type PRHistoryRepository interface {
+	AddPREvent(ctx context.Context, prID, action string, details map[string]any) error
+}
+
+// ReviewEvents is notified about reviewer replacement outcomes, e.g. to export metrics.
+// NoCandidate is reported right away; reassignments inside a larger transaction only once it commits.
+type ReviewEvents interface {
+	NoCandidate()
+	Reassigned()
+}
+
+type noopReviewEvents struct{}
+
+func (noopReviewEvents) NoCandidate() {}
+func (noopReviewEvents) Reassigned()  {}
+
+const batchChunkSize = 100
+
 type PullRequestService struct {
-	prRepo     PullRequestRepository
-	reviewRepo ReviewRepository
-	teamsRepo  TeamInfoRepository
-	txMgr      TransactionManager
+	prRepo      PullRequestRepository
+	reviewRepo  ReviewRepository
+	teamsRepo   TeamInfoRepository
+	historyRepo PRHistoryRepository
+	txMgr       TransactionManager
+	deleteMode  models.DeleteMode
+	events      ReviewEvents
 }
 
 func NewPullRequestService(
 	prRepo PullRequestRepository,
 	reviewRepo ReviewRepository,
 	teamsRepo TeamInfoRepository,
+	historyRepo PRHistoryRepository,
 	txMgr TransactionManager,
+	deleteMode models.DeleteMode,
+	events ReviewEvents,
 ) *PullRequestService {
+	if events == nil {
+		events = noopReviewEvents{}
+	}
+
 	return &PullRequestService{
-		prRepo:     prRepo,
-		reviewRepo: reviewRepo,
-		teamsRepo:  teamsRepo,
-		txMgr:      txMgr,
+		prRepo:      prRepo,
+		reviewRepo:  reviewRepo,
+		teamsRepo:   teamsRepo,
+		historyRepo: historyRepo,
+		txMgr:       txMgr,
+		deleteMode:  deleteMode,
+		events:      events,
 	}
 }
 
-func pickRandom(src []string, n int) []string {
+// pickWeighted draws up to n candidates without replacement, with probability proportional to their weight.
+func pickWeighted(src []*models.ReviewCandidate, n int) []string {
 	if len(src) == 0 || n <= 0 {
 		return nil
 	}
-	if len(src) <= n {
-		return append([]string{}, src...)
+
+	type keyed struct {
+		userID string
+		key    float64
+	}
+
+	keys := make([]keyed, 0, len(src))
+	for _, c := range src {
+		if c.Weight <= 0 {
+			continue
+		}
+		keys = append(keys, keyed{userID: c.UserID, key: rand.ExpFloat64() / c.Weight})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].key < keys[j].key
+	})
+
+	out := make([]string, 0, min(n, len(keys)))
+	for _, k := range keys[:min(n, len(keys))] {
+		out = append(out, k.userID)
 	}
+	return out
+}
 
-	out := append([]string{}, src...)
-	for i := range out {
-		j := i + int(time.Now().UnixNano()%int64(len(out)-i))
+// pickBalanced prefers candidates with the lowest load relative to their weight, breaking ties randomly.
+func pickBalanced(src []*models.ReviewCandidate, load map[string]int, n int) []string {
+	if len(src) == 0 || n <= 0 {
+		return nil
+	}
+
+	out := make([]*models.ReviewCandidate, 0, len(src))
+	for _, c := range src {
+		if c.Weight > 0 {
+			out = append(out, c)
+		}
+	}
+	rand.Shuffle(len(out), func(i, j int) {
 		out[i], out[j] = out[j], out[i]
+	})
+	sort.SliceStable(out, func(i, j int) bool {
+		return float64(load[out[i].UserID])/out[i].Weight < float64(load[out[j].UserID])/out[j].Weight
+	})
+
+	ids := make([]string, 0, min(n, len(out)))
+	for _, c := range out[:min(n, len(out))] {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
+
+// findCandidates returns active members of the given teams that are not excluded, deduplicated across
+// teams with the highest weight kept. When none are found, the search widens from the first (primary)
+// team to its sibling teams and then continues up the parent chain.
+func (s *PullRequestService) findCandidates(ctx context.Context, teams []string, excludeUserID string, excluded []string) ([]*models.ReviewCandidate, error) {
+	visited := make(map[string]bool)
+	var candidates []*models.ReviewCandidate
+	byUser := make(map[string]*models.ReviewCandidate)
+
+	collect := func(team string) error {
+		visited[team] = true
+		members, err := s.teamsRepo.GetActiveTeamCandidates(ctx, team, excludeUserID)
+		if err != nil {
+			return fmt.Errorf("getting team members: %w", err)
+		}
+		for _, m := range members {
+			if slices.Contains(excluded, m.UserID) {
+				continue
+			}
+			if c, ok := byUser[m.UserID]; ok {
+				c.Weight = max(c.Weight, m.Weight)
+				continue
+			}
+			c := &models.ReviewCandidate{UserID: m.UserID, Weight: m.Weight}
+			byUser[m.UserID] = c
+			candidates = append(candidates, c)
+		}
+		return nil
+	}
+
+	for _, team := range teams {
+		if err := collect(team); err != nil {
+			return nil, err
+		}
+	}
+	if len(candidates) > 0 || len(teams) == 0 {
+		return candidates, nil
+	}
+
+	team := teams[0]
+	for {
+		parent, err := s.teamsRepo.GetParentTeam(ctx, team)
+		if err != nil {
+			return nil, fmt.Errorf("getting parent team: %w", err)
+		}
+		if parent == "" || visited[parent] {
+			return nil, nil
+		}
+
+		siblings, err := s.teamsRepo.GetChildTeams(ctx, parent)
+		if err != nil {
+			return nil, fmt.Errorf("getting child teams: %w", err)
+		}
+		for _, sibling := range siblings {
+			if visited[sibling] {
+				continue
+			}
+			if err := collect(sibling); err != nil {
+				return nil, err
+			}
+		}
+		if len(candidates) > 0 {
+			return candidates, nil
+		}
+
+		if err := collect(parent); err != nil {
+			return nil, err
+		}
+		if len(candidates) > 0 {
+			return candidates, nil
+		}
+		team = parent
 	}
-	return out[:n]
 }
 
 func (s *PullRequestService) CreatePR(ctx context.Context, pr *models.PullRequest) (*models.PullRequest, error) {
@@ -77,17 +231,20 @@ func (s *PullRequestService) CreatePR(ctx context.Context, pr *models.PullReques
 			return fmt.Errorf("creating PR: %w", err)
 		}
 
-		teamName, err := s.teamsRepo.GetUserTeam(txCtx, pr.AuthorID)
+		teams, err := s.teamsRepo.GetUserTeams(txCtx, pr.AuthorID)
 		if err != nil {
-			return fmt.Errorf("getting author team: %w", err)
+			return fmt.Errorf("getting author teams: %w", err)
+		}
+		if len(teams) == 0 {
+			return fmt.Errorf("author %s has no team", pr.AuthorID)
 		}
 
-		candidates, err := s.teamsRepo.GetActiveTeamMembers(txCtx, teamName, pr.AuthorID)
+		candidates, err := s.findCandidates(txCtx, teams, pr.AuthorID, nil)
 		if err != nil {
-			return fmt.Errorf("getting team members: %w", err)
+			return err
 		}
 
-		assigned := pickRandom(candidates, 2)
+		assigned := pickWeighted(candidates, 2)
 		for _, reviewerID := range assigned {
 			if err := s.reviewRepo.AddReviewer(txCtx, pr.PullRequestID, reviewerID); err != nil {
 				return fmt.Errorf("adding reviewer: %w", err)
@@ -109,6 +266,112 @@ func (s *PullRequestService) CreatePR(ctx context.Context, pr *models.PullReques
 	return result, nil
 }
 
+// BatchCreatePR creates pull requests in chunked transactions and reports a result per item.
+// Reviewer load accumulated by earlier items of the batch is taken into account when assigning.
+func (s *PullRequestService) BatchCreatePR(ctx context.Context, prs []*models.PullRequest) ([]*models.BatchCreatePRResult, error) {
+	results := make([]*models.BatchCreatePRResult, len(prs))
+	for i, pr := range prs {
+		results[i] = &models.BatchCreatePRResult{PullRequestID: pr.PullRequestID}
+	}
+
+	load := make(map[string]int)
+	seen := make(map[string]bool)
+
+	for start := 0; start < len(prs); start += batchChunkSize {
+		end := min(start+batchChunkSize, len(prs))
+
+		chunkLoad := make(map[string]int)
+		chunkSeen := make(map[string]bool)
+		// settled marks results that stay valid if the chunk rolls back.
+		settled := make(map[int]bool)
+
+		err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+			for i := start; i < end; i++ {
+				pr := prs[i]
+
+				if seen[pr.PullRequestID] || chunkSeen[pr.PullRequestID] {
+					results[i].Error = &models.ErrorObject{Code: models.ErrPRExists, Message: "PR id already exists"}
+					settled[i] = seen[pr.PullRequestID]
+					continue
+				}
+
+				exists, err := s.prRepo.PRExists(txCtx, pr.PullRequestID)
+				if err != nil {
+					return fmt.Errorf("checking PR %s: %w", pr.PullRequestID, err)
+				}
+				if exists {
+					results[i].Error = &models.ErrorObject{Code: models.ErrPRExists, Message: "PR id already exists"}
+					settled[i] = true
+					continue
+				}
+
+				teams, err := s.teamsRepo.GetUserTeams(txCtx, pr.AuthorID)
+				if err != nil {
+					return fmt.Errorf("getting author teams: %w", err)
+				}
+				if len(teams) == 0 {
+					results[i].Error = &models.ErrorObject{Code: models.ErrNotFound, Message: "author/team not found"}
+					settled[i] = true
+					continue
+				}
+
+				created := *pr
+				if created.Status == "" {
+					created.Status = models.StatusOpen
+				}
+				if err := s.prRepo.CreatePR(txCtx, &created); err != nil {
+					return fmt.Errorf("creating PR %s: %w", pr.PullRequestID, err)
+				}
+				chunkSeen[pr.PullRequestID] = true
+
+				candidates, err := s.findCandidates(txCtx, teams, pr.AuthorID, nil)
+				if err != nil {
+					return err
+				}
+
+				assigned := pickBalanced(candidates, load, 2)
+				for _, reviewerID := range assigned {
+					if err := s.reviewRepo.AddReviewer(txCtx, pr.PullRequestID, reviewerID); err != nil {
+						return fmt.Errorf("adding reviewer: %w", err)
+					}
+					load[reviewerID]++
+					chunkLoad[reviewerID]++
+				}
+
+				results[i].PR, err = s.getPRWithReviewers(txCtx, pr.PullRequestID)
+				if err != nil {
+					return fmt.Errorf("getting created PR: %w", err)
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			for id, n := range chunkLoad {
+				load[id] -= n
+			}
+			for i := start; i < end; i++ {
+				if settled[i] {
+					continue
+				}
+				results[i].PR = nil
+				results[i].Error = &models.ErrorObject{Code: models.ErrInternal, Message: "batch chunk failed"}
+			}
+			continue
+		}
+
+		for id := range chunkSeen {
+			seen[id] = true
+		}
+	}
+
+	return results, nil
+}
+
 func (s *PullRequestService) getPRWithReviewers(ctx context.Context, prID string) (*models.PullRequest, error) {
 	pr, err := s.prRepo.GetPR(ctx, prID)
 	if err != nil {
@@ -124,14 +387,39 @@ func (s *PullRequestService) getPRWithReviewers(ctx context.Context, prID string
 	return pr, nil
 }
 
+// lockPRWithReviewers takes a row lock on the PR for the rest of the transaction.
+// A non-zero expectedVersion must match the stored version, otherwise VERSION_MISMATCH is returned.
+func (s *PullRequestService) lockPRWithReviewers(ctx context.Context, prID string, expectedVersion int) (*models.PullRequest, error) {
+	pr, err := s.prRepo.GetPRForUpdate(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("locking PR: %w", err)
+	}
+
+	if expectedVersion != 0 && pr.Version != expectedVersion {
+		return nil, errors.New("VERSION_MISMATCH")
+	}
+
+	reviewers, err := s.reviewRepo.GetPRReviewers(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("getting reviewers: %w", err)
+	}
+	pr.Assigned = reviewers
+
+	return pr, nil
+}
+
 func (s *PullRequestService) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
 	return s.getPRWithReviewers(ctx, prID)
 }
 
-func (s *PullRequestService) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
+func (s *PullRequestService) MergePR(ctx context.Context, prID string, expectedVersion int) (*models.PullRequest, error) {
 	var result *models.PullRequest
 
 	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.lockPRWithReviewers(txCtx, prID, expectedVersion); err != nil {
+			return err
+		}
+
 		if err := s.prRepo.MergePR(txCtx, prID); err != nil {
 			return fmt.Errorf("merging PR: %w", err)
 		}
@@ -146,20 +434,74 @@ func (s *PullRequestService) MergePR(ctx context.Context, prID string) (*models.
 	})
 
 	if err != nil {
+		if err.Error() == "VERSION_MISMATCH" {
+			return nil, fmt.Errorf("error: code: VERSION_MISMATCH, message: PR was modified concurrently")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeletePR removes a PR according to the configured delete mode and records the deletion in history.
+// Reviewers are released explicitly in both modes so that the reviewer counters follow.
+func (s *PullRequestService) DeletePR(ctx context.Context, prID string, expectedVersion int) (*models.PullRequest, error) {
+	var result *models.PullRequest
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.lockPRWithReviewers(txCtx, prID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if err := s.reviewRepo.RemoveAllReviewers(txCtx, prID); err != nil {
+			return fmt.Errorf("releasing reviewers: %w", err)
+		}
+
+		switch s.deleteMode {
+		case models.DeleteModeHard:
+			if err := s.prRepo.DeletePR(txCtx, prID); err != nil {
+				return fmt.Errorf("deleting PR: %w", err)
+			}
+		default:
+			if err := s.prRepo.SoftDeletePR(txCtx, prID); err != nil {
+				return fmt.Errorf("soft deleting PR: %w", err)
+			}
+		}
+
+		details := map[string]any{
+			"mode":              s.deleteMode,
+			"pull_request_name": pr.PullRequestName,
+			"author_id":         pr.AuthorID,
+			"status":            pr.Status,
+			"reviewers":         pr.Assigned,
+		}
+		if err := s.historyRepo.AddPREvent(txCtx, prID, "DELETED", details); err != nil {
+			return fmt.Errorf("recording deletion: %w", err)
+		}
+
+		result = pr
+		return nil
+	})
+
+	if err != nil {
+		if err.Error() == "VERSION_MISMATCH" {
+			return nil, fmt.Errorf("error: code: VERSION_MISMATCH, message: PR was modified concurrently")
+		}
 		return nil, err
 	}
 
 	return result, nil
 }
 
-func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*models.PullRequest, string, error) {
+func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string, expectedVersion int) (*models.PullRequest, string, error) {
 	var result *models.PullRequest
 	var newReviewerID string
 
 	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		pr, err := s.getPRWithReviewers(txCtx, prID)
+		pr, err := s.lockPRWithReviewers(txCtx, prID, expectedVersion)
 		if err != nil {
-			return fmt.Errorf("getting PR: %w", err)
+			return err
 		}
 
 		if pr.Status == models.StatusMerged {
@@ -170,33 +512,21 @@ func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldRevi
 			return errors.New("NOT_ASSIGNED")
 		}
 
-		teamName, err := s.teamsRepo.GetUserTeam(txCtx, oldReviewerID)
+		teams, err := s.teamsRepo.GetUserTeams(txCtx, oldReviewerID)
 		if err != nil {
-			return fmt.Errorf("getting reviewer team: %w", err)
+			return fmt.Errorf("getting reviewer teams: %w", err)
 		}
 
-		candidates, err := s.teamsRepo.GetActiveTeamMembers(txCtx, teamName, oldReviewerID)
+		filteredCandidates, err := s.findCandidates(txCtx, teams, oldReviewerID, append([]string{pr.AuthorID}, pr.Assigned...))
 		if err != nil {
-			return fmt.Errorf("getting team members: %w", err)
-		}
-
-		filteredCandidates := make([]string, 0)
-		for _, candidate := range candidates {
-			if slices.Contains(pr.Assigned, candidate) {
-				continue
-			}
-
-			if candidate == pr.AuthorID {
-				continue
-			}
-			filteredCandidates = append(filteredCandidates, candidate)
+			return err
 		}
 
 		if len(filteredCandidates) == 0 {
 			return errors.New("NO_CANDIDATE")
 		}
 
-		selected := pickRandom(filteredCandidates, 1)
+		selected := pickWeighted(filteredCandidates, 1)
 		if len(selected) == 0 {
 			return errors.New("NO_CANDIDATE")
 		}
@@ -210,6 +540,10 @@ func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldRevi
 			return fmt.Errorf("adding new reviewer: %w", err)
 		}
 
+		if err := s.prRepo.BumpVersion(txCtx, prID); err != nil {
+			return fmt.Errorf("bumping PR version: %w", err)
+		}
+
 		result, err = s.getPRWithReviewers(txCtx, prID)
 		if err != nil {
 			return fmt.Errorf("getting updated PR: %w", err)
@@ -225,11 +559,124 @@ func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldRevi
 		case "NOT_ASSIGNED":
 			return nil, "", fmt.Errorf("error: code: NOT_ASSIGNED, message: reviewer is not assigned to this PR")
 		case "NO_CANDIDATE":
+			s.events.NoCandidate()
 			return nil, "", fmt.Errorf("error: code: NO_CANDIDATE, message: no active replacement candidate in team")
+		case "VERSION_MISMATCH":
+			return nil, "", fmt.Errorf("error: code: VERSION_MISMATCH, message: PR was modified concurrently")
 		default:
 			return nil, "", err
 		}
 	}
 
+	onCommit(ctx, s.events.Reassigned)
 	return result, newReviewerID, nil
 }
+
+func (s *PullRequestService) StartReview(ctx context.Context, prID, userID string, expectedVersion int) (*models.ReviewAssignment, int, error) {
+	var result *models.ReviewAssignment
+	var version int
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.lockPRWithReviewers(txCtx, prID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == models.StatusMerged {
+			return errors.New("PR_MERGED")
+		}
+
+		if !slices.Contains(pr.Assigned, userID) {
+			return errors.New("NOT_ASSIGNED")
+		}
+
+		result, err = s.reviewRepo.StartReview(txCtx, prID, userID)
+		if err != nil {
+			return fmt.Errorf("starting review: %w", err)
+		}
+
+		if err := s.prRepo.BumpVersion(txCtx, prID); err != nil {
+			return fmt.Errorf("bumping PR version: %w", err)
+		}
+		version = pr.Version + 1
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "VERSION_MISMATCH":
+			return nil, 0, fmt.Errorf("error: code: VERSION_MISMATCH, message: PR was modified concurrently")
+		case "PR_MERGED":
+			return nil, 0, fmt.Errorf("error: code: PR_MERGED, message: cannot start review on merged PR")
+		case "NOT_ASSIGNED":
+			return nil, 0, fmt.Errorf("error: code: NOT_ASSIGNED, message: reviewer is not assigned to this PR")
+		default:
+			return nil, 0, err
+		}
+	}
+
+	return result, version, nil
+}
+
+// ReplaceReviewer hands a review over to a specific user. If newReviewerID is already assigned,
+// the old reviewer is simply released.
+func (s *PullRequestService) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) (*models.PullRequest, error) {
+	var result *models.PullRequest
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.lockPRWithReviewers(txCtx, prID, 0)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == models.StatusMerged {
+			return errors.New("PR_MERGED")
+		}
+
+		if !slices.Contains(pr.Assigned, oldReviewerID) {
+			return errors.New("NOT_ASSIGNED")
+		}
+
+		if newReviewerID == pr.AuthorID {
+			return errors.New("NO_CANDIDATE")
+		}
+
+		if err := s.reviewRepo.RemoveReviewer(txCtx, prID, oldReviewerID); err != nil {
+			return fmt.Errorf("removing old reviewer: %w", err)
+		}
+
+		if !slices.Contains(pr.Assigned, newReviewerID) {
+			if err := s.reviewRepo.AddReviewer(txCtx, prID, newReviewerID); err != nil {
+				return fmt.Errorf("adding new reviewer: %w", err)
+			}
+		}
+
+		if err := s.prRepo.BumpVersion(txCtx, prID); err != nil {
+			return fmt.Errorf("bumping PR version: %w", err)
+		}
+
+		result, err = s.getPRWithReviewers(txCtx, prID)
+		if err != nil {
+			return fmt.Errorf("getting updated PR: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "PR_MERGED":
+			return nil, fmt.Errorf("error: code: PR_MERGED, message: cannot reassign on merged PR")
+		case "NOT_ASSIGNED":
+			return nil, fmt.Errorf("error: code: NOT_ASSIGNED, message: reviewer is not assigned to this PR")
+		case "NO_CANDIDATE":
+			return nil, fmt.Errorf("error: code: NO_CANDIDATE, message: author cannot review own PR")
+		default:
+			return nil, err
+		}
+	}
+
+	onCommit(ctx, s.events.Reassigned)
+	return result, nil
+}
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -21,6 +22,14 @@ func expectTx(tx *mocks.TransactionManager) {
 		})
 }
 
+func candidates(userIDs ...string) []*models.ReviewCandidate {
+	out := make([]*models.ReviewCandidate, 0, len(userIDs))
+	for _, id := range userIDs {
+		out = append(out, &models.ReviewCandidate{UserID: id, Weight: 1})
+	}
+	return out
+}
+
 func TestCreatePR(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -36,8 +45,8 @@ func TestCreatePR(t *testing.T) {
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
 				pr.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
 
-				team.On("GetUserTeam", mock.Anything, "author").Return("teamA", nil)
-				team.On("GetActiveTeamMembers", mock.Anything, "teamA", "author").Return([]string{"u1", "u2", "u3"}, nil)
+				team.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return(candidates("u1", "u2", "u3"), nil)
 
 				rev.On("AddReviewer", mock.Anything, "pr1", mock.Anything).Return(nil)
 
@@ -58,10 +67,10 @@ func TestCreatePR(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "GetUserTeam error",
+			name: "GetUserTeams error",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
 				pr.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
-				team.On("GetUserTeam", mock.Anything, "author").Return("", errors.New("no team"))
+				team.On("GetUserTeams", mock.Anything, "author").Return(nil, errors.New("db error"))
 			},
 			wantErr: true,
 		},
@@ -70,8 +79,9 @@ func TestCreatePR(t *testing.T) {
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
 				pr.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
 
-				team.On("GetUserTeam", mock.Anything, "author").Return("teamA", nil)
-				team.On("GetActiveTeamMembers", mock.Anything, "teamA", "author").Return([]string{}, nil)
+				team.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "teamA").Return("", nil)
 
 				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
 					PullRequestID: "pr1",
@@ -87,8 +97,8 @@ func TestCreatePR(t *testing.T) {
 			name: "AddReviewer fails",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
 				pr.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
-				team.On("GetUserTeam", mock.Anything, "author").Return("teamA", nil)
-				team.On("GetActiveTeamMembers", mock.Anything, "teamA", "author").Return([]string{"u1"}, nil)
+				team.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return(candidates("u1"), nil)
 
 				rev.On("AddReviewer", mock.Anything, "pr1", "u1").Return(errors.New("fail"))
 			},
@@ -102,12 +112,13 @@ func TestCreatePR(t *testing.T) {
 			prRepo := mocks.NewPullRequestRepository(t)
 			revRepo := mocks.NewReviewRepository(t)
 			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			expectTx(txMgr)
 			tt.setup(prRepo, revRepo, teamRepo)
 
-			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, txMgr)
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
 
 			pr := &models.PullRequest{
 				PullRequestID: "pr1",
@@ -125,43 +136,185 @@ func TestCreatePR(t *testing.T) {
 	}
 }
 
+func TestCreatePR_ParentTeamFallback(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(team *mocks.TeamInfoRepository)
+		want  []string
+	}{
+		{
+			name: "sibling squads are tried first",
+			setup: func(team *mocks.TeamInfoRepository) {
+				team.On("GetActiveTeamCandidates", mock.Anything, "squadA", "author").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "squadA").Return("backend", nil)
+				team.On("GetChildTeams", mock.Anything, "backend").Return([]string{"squadA", "squadB"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "squadB", "author").Return(candidates("s1"), nil)
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "parent team when siblings are empty",
+			setup: func(team *mocks.TeamInfoRepository) {
+				team.On("GetActiveTeamCandidates", mock.Anything, "squadA", "author").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "squadA").Return("backend", nil)
+				team.On("GetChildTeams", mock.Anything, "backend").Return([]string{"squadA", "squadB"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "squadB", "author").Return(candidates(), nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "backend", "author").Return(candidates("lead"), nil)
+			},
+			want: []string{"lead"},
+		},
+		{
+			name: "walks up to the department",
+			setup: func(team *mocks.TeamInfoRepository) {
+				team.On("GetActiveTeamCandidates", mock.Anything, "squadA", "author").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "squadA").Return("backend", nil)
+				team.On("GetChildTeams", mock.Anything, "backend").Return([]string{"squadA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "backend", "author").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "backend").Return("engineering", nil)
+				team.On("GetChildTeams", mock.Anything, "engineering").Return([]string{"backend", "frontend"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "frontend", "author").Return(candidates("f1", "f2"), nil)
+			},
+			want: []string{"f1", "f2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewPullRequestRepository(t)
+			revRepo := mocks.NewReviewRepository(t)
+			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			prRepo.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
+			teamRepo.On("GetUserTeams", mock.Anything, "author").Return([]string{"squadA"}, nil)
+			tt.setup(teamRepo)
+
+			var added []string
+			revRepo.On("AddReviewer", mock.Anything, "pr1", mock.Anything).
+				Run(func(args mock.Arguments) {
+					added = append(added, args.String(2))
+				}).
+				Return(nil)
+			prRepo.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}, nil)
+			revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return(tt.want, nil)
+
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+			_, err := svc.CreatePR(context.Background(), &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"})
+			require.NoError(t, err)
+
+			slices.Sort(added)
+			require.Equal(t, tt.want, added)
+		})
+	}
+}
+
+func TestCreatePR_MultipleTeams(t *testing.T) {
+	prRepo := mocks.NewPullRequestRepository(t)
+	revRepo := mocks.NewReviewRepository(t)
+	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+	prRepo.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
+	teamRepo.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA", "teamB"}, nil)
+	teamRepo.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return([]*models.ReviewCandidate{
+		{UserID: "u1", Weight: 1},
+		{UserID: "u2", Weight: 0},
+	}, nil)
+	teamRepo.On("GetActiveTeamCandidates", mock.Anything, "teamB", "author").Return([]*models.ReviewCandidate{
+		{UserID: "u1", Weight: 2},
+		{UserID: "u3", Weight: 1},
+	}, nil)
+
+	var added []string
+	revRepo.On("AddReviewer", mock.Anything, "pr1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			added = append(added, args.String(2))
+		}).
+		Return(nil)
+	prRepo.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}, nil)
+	revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"u1", "u3"}, nil)
+
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+	_, err := svc.CreatePR(context.Background(), &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"})
+	require.NoError(t, err)
+
+	slices.Sort(added)
+	require.Equal(t, []string{"u1", "u3"}, added)
+}
+
 func TestMergePR(t *testing.T) {
 	prRepo := mocks.NewPullRequestRepository(t)
 	revRepo := mocks.NewReviewRepository(t)
 	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
 	txMgr := mocks.NewTransactionManager(t)
 
 	expectTx(txMgr)
 
+	prRepo.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+		PullRequestID: "pr1",
+		Version:       1,
+	}, nil)
 	prRepo.On("MergePR", mock.Anything, "pr1").Return(nil)
 	prRepo.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
 		PullRequestID: "pr1",
 		Assigned:      []string{"u1"},
+		Version:       2,
 	}, nil)
 	revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"u1"}, nil)
 
-	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, txMgr)
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
 
-	_, err := svc.MergePR(context.Background(), "pr1")
+	res, err := svc.MergePR(context.Background(), "pr1", 1)
 	require.NoError(t, err)
+	require.Equal(t, 2, res.Version)
 }
 
 func TestMergePR_Error(t *testing.T) {
 	prRepo := mocks.NewPullRequestRepository(t)
 	revRepo := mocks.NewReviewRepository(t)
 	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
 	txMgr := mocks.NewTransactionManager(t)
 
 	expectTx(txMgr)
 
+	prRepo.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1"}, nil)
+	revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{}, nil)
 	prRepo.On("MergePR", mock.Anything, "pr1").Return(errors.New("fail"))
 
-	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, txMgr)
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
 
-	_, err := svc.MergePR(context.Background(), "pr1")
+	_, err := svc.MergePR(context.Background(), "pr1", 0)
 	require.Error(t, err)
 }
 
+func TestMergePR_VersionMismatch(t *testing.T) {
+	prRepo := mocks.NewPullRequestRepository(t)
+	revRepo := mocks.NewReviewRepository(t)
+	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+
+	prRepo.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+		PullRequestID: "pr1",
+		Version:       3,
+	}, nil)
+
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+	_, err := svc.MergePR(context.Background(), "pr1", 2)
+	require.ErrorContains(t, err, "VERSION_MISMATCH")
+}
+
 func TestGetPR(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -237,11 +390,12 @@ func TestGetPR(t *testing.T) {
 			prRepo := mocks.NewPullRequestRepository(t)
 			revRepo := mocks.NewReviewRepository(t)
 			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			tt.setup(prRepo, revRepo)
 
-			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, txMgr)
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
 
 			result, err := svc.GetPR(context.Background(), "pr1")
 
@@ -268,12 +422,14 @@ func TestReassignReviewer(t *testing.T) {
 			rev *mocks.ReviewRepository,
 			team *mocks.TeamInfoRepository,
 		)
-		wantErr bool
+		wantErr         bool
+		wantReassigned  int
+		wantNoCandidate int
 	}{
 		{
 			name: "success",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
-				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
 					PullRequestID: "pr1",
 					AuthorID:      "author",
 					Status:        models.StatusOpen,
@@ -281,11 +437,12 @@ func TestReassignReviewer(t *testing.T) {
 				}, nil)
 				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"old"}, nil)
 
-				team.On("GetUserTeam", mock.Anything, "old").Return("teamA", nil)
-				team.On("GetActiveTeamMembers", mock.Anything, "teamA", "old").Return([]string{"c1"}, nil)
+				team.On("GetUserTeams", mock.Anything, "old").Return([]string{"teamA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "teamA", "old").Return(candidates("c1"), nil)
 
 				rev.On("RemoveReviewer", mock.Anything, "pr1", "old").Return(nil)
 				rev.On("AddReviewer", mock.Anything, "pr1", "c1").Return(nil)
+				pr.On("BumpVersion", mock.Anything, "pr1").Return(nil)
 
 				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
 					PullRequestID: "pr1",
@@ -295,12 +452,13 @@ func TestReassignReviewer(t *testing.T) {
 				}, nil)
 				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"c1"}, nil)
 			},
-			wantErr: false,
+			wantErr:        false,
+			wantReassigned: 1,
 		},
 		{
 			name: "PR merged",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
-				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
 					Status:   models.StatusMerged,
 					Assigned: []string{"old"},
 				}, nil)
@@ -311,7 +469,7 @@ func TestReassignReviewer(t *testing.T) {
 		{
 			name: "old reviewer not assigned",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
-				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
 					Status:   models.StatusOpen,
 					Assigned: []string{"x"},
 				}, nil)
@@ -322,17 +480,19 @@ func TestReassignReviewer(t *testing.T) {
 		{
 			name: "no candidates",
 			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
-				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
 					Status:   models.StatusOpen,
 					AuthorID: "author",
 					Assigned: []string{"old"},
 				}, nil)
 				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"old"}, nil)
 
-				team.On("GetUserTeam", mock.Anything, "old").Return("teamA", nil)
-				team.On("GetActiveTeamMembers", mock.Anything, "teamA", "old").Return([]string{}, nil)
+				team.On("GetUserTeams", mock.Anything, "old").Return([]string{"teamA"}, nil)
+				team.On("GetActiveTeamCandidates", mock.Anything, "teamA", "old").Return(candidates(), nil)
+				team.On("GetParentTeam", mock.Anything, "teamA").Return("", nil)
 			},
-			wantErr: true,
+			wantErr:         true,
+			wantNoCandidate: 1,
 		},
 	}
 
@@ -342,17 +502,380 @@ func TestReassignReviewer(t *testing.T) {
 			prRepo := mocks.NewPullRequestRepository(t)
 			revRepo := mocks.NewReviewRepository(t)
 			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			expectTx(txMgr)
 			tt.setup(prRepo, revRepo, teamRepo)
 
-			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, txMgr)
+			events := mocks.NewReviewEvents(t)
+			if tt.wantReassigned > 0 {
+				events.EXPECT().Reassigned().Times(tt.wantReassigned)
+			}
+			if tt.wantNoCandidate > 0 {
+				events.EXPECT().NoCandidate().Times(tt.wantNoCandidate)
+			}
+
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, events)
+
+			_, _, err := svc.ReassignReviewer(context.Background(), "pr1", "old", 0)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchCreatePR(t *testing.T) {
+	prRepo := mocks.NewPullRequestRepository(t)
+	revRepo := mocks.NewReviewRepository(t)
+	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+
+	prRepo.On("PRExists", mock.Anything, "pr1").Return(false, nil)
+	prRepo.On("PRExists", mock.Anything, "pr2").Return(false, nil)
+	prRepo.On("PRExists", mock.Anything, "existing").Return(true, nil)
+	prRepo.On("PRExists", mock.Anything, "orphan").Return(false, nil)
+
+	teamRepo.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA"}, nil)
+	teamRepo.On("GetUserTeams", mock.Anything, "ghost").Return([]string{}, nil)
+	teamRepo.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return(candidates("u1", "u2", "u3"), nil)
+
+	prRepo.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
+
+	assigned := make(map[string][]string)
+	revRepo.On("AddReviewer", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			prID := args.String(1)
+			assigned[prID] = append(assigned[prID], args.String(2))
+		}).
+		Return(nil)
+
+	for _, id := range []string{"pr1", "pr2"} {
+		prRepo.On("GetPR", mock.Anything, id).Return(&models.PullRequest{
+			PullRequestID: id,
+			AuthorID:      "author",
+			Status:        models.StatusOpen,
+		}, nil)
+		revRepo.On("GetPRReviewers", mock.Anything, id).Return([]string{}, nil)
+	}
+
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+	results, err := svc.BatchCreatePR(context.Background(), []*models.PullRequest{
+		{PullRequestID: "pr1", AuthorID: "author"},
+		{PullRequestID: "existing", AuthorID: "author"},
+		{PullRequestID: "pr2", AuthorID: "author"},
+		{PullRequestID: "pr1", AuthorID: "author"},
+		{PullRequestID: "orphan", AuthorID: "ghost"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	require.NotNil(t, results[0].PR)
+	require.Nil(t, results[0].Error)
+	require.Equal(t, models.ErrPRExists, results[1].Error.Code)
+	require.NotNil(t, results[2].PR)
+	require.Equal(t, models.ErrPRExists, results[3].Error.Code)
+	require.Equal(t, models.ErrNotFound, results[4].Error.Code)
+
+	require.Len(t, assigned["pr1"], 2)
+	require.Len(t, assigned["pr2"], 2)
+
+	// the member left out of pr1 has the lowest load and must be picked for pr2
+	for _, u := range []string{"u1", "u2", "u3"} {
+		if !slices.Contains(assigned["pr1"], u) {
+			require.Contains(t, assigned["pr2"], u)
+		}
+	}
+}
+
+func TestBatchCreatePR_ChunkFailure(t *testing.T) {
+	prRepo := mocks.NewPullRequestRepository(t)
+	revRepo := mocks.NewReviewRepository(t)
+	teamRepo := mocks.NewTeamInfoRepository(t)
+	histRepo := mocks.NewPRHistoryRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+
+	prRepo.On("PRExists", mock.Anything, "pr1").Return(false, nil)
+	prRepo.On("PRExists", mock.Anything, "existing").Return(true, nil)
+	prRepo.On("PRExists", mock.Anything, "orphan").Return(false, nil)
+	prRepo.On("PRExists", mock.Anything, "pr2").Return(false, errors.New("db down"))
+
+	teamRepo.On("GetUserTeams", mock.Anything, "author").Return([]string{"teamA"}, nil)
+	teamRepo.On("GetUserTeams", mock.Anything, "ghost").Return([]string{}, nil)
+	teamRepo.On("GetActiveTeamCandidates", mock.Anything, "teamA", "author").Return(candidates("u1"), nil)
+
+	prRepo.On("CreatePR", mock.Anything, mock.Anything).Return(nil)
+	revRepo.On("AddReviewer", mock.Anything, "pr1", "u1").Return(nil)
+	prRepo.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1", Status: models.StatusOpen}, nil)
+	revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{}, nil)
+
+	svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+	prs := []*models.PullRequest{
+		{PullRequestID: "pr1", AuthorID: "author"},
+		{PullRequestID: "existing", AuthorID: "author"},
+		{PullRequestID: "pr1", AuthorID: "author"},
+		{PullRequestID: "orphan", AuthorID: "ghost"},
+		{PullRequestID: "pr2", AuthorID: "author"},
+	}
+	results, err := svc.BatchCreatePR(context.Background(), prs)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	// the duplicate of pr1 was rolled back together with pr1, so it must not report PR_EXISTS
+	for _, i := range []int{0, 2, 4} {
+		require.Nil(t, results[i].PR)
+		require.Equal(t, models.ErrInternal, results[i].Error.Code)
+	}
+	require.Equal(t, models.ErrPRExists, results[1].Error.Code)
+	require.Equal(t, models.ErrNotFound, results[3].Error.Code)
+	require.Empty(t, prs[0].Status)
+}
+
+func TestStartReview(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   models.PullRequestStatus
+		assigned []string
+		version  int
+		setup    func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository)
+		wantErr  string
+	}{
+		{
+			name:     "success",
+			status:   models.StatusOpen,
+			assigned: []string{"u1"},
+			version:  3,
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository) {
+				rev.On("StartReview", mock.Anything, "pr1", "u1").Return(&models.ReviewAssignment{
+					PullRequestID: "pr1",
+					UserID:        "u1",
+				}, nil)
+				pr.On("BumpVersion", mock.Anything, "pr1").Return(nil)
+			},
+		},
+		{
+			name:     "version mismatch",
+			status:   models.StatusOpen,
+			assigned: []string{"u1"},
+			version:  2,
+			wantErr:  "VERSION_MISMATCH",
+		},
+		{
+			name:     "PR merged",
+			status:   models.StatusMerged,
+			assigned: []string{"u1"},
+			wantErr:  "PR_MERGED",
+		},
+		{
+			name:     "not assigned",
+			status:   models.StatusOpen,
+			assigned: []string{"u2"},
+			wantErr:  "NOT_ASSIGNED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewPullRequestRepository(t)
+			revRepo := mocks.NewReviewRepository(t)
+			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+
+			prRepo.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+				PullRequestID: "pr1",
+				Status:        tt.status,
+				Version:       3,
+			}, nil)
+			revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return(tt.assigned, nil).Maybe()
+			if tt.setup != nil {
+				tt.setup(prRepo, revRepo)
+			}
+
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+			res, version, err := svc.StartReview(context.Background(), "pr1", "u1", tt.version)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "u1", res.UserID)
+				require.Equal(t, 4, version)
+			}
+		})
+	}
+}
+
+func TestDeletePR(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    models.DeleteMode
+		setup   func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, hist *mocks.PRHistoryRepository)
+		wantErr bool
+	}{
+		{
+			name: "soft delete releases reviewers",
+			mode: models.DeleteModeSoft,
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, hist *mocks.PRHistoryRepository) {
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+					PullRequestID: "pr1",
+					AuthorID:      "author",
+					Status:        models.StatusOpen,
+				}, nil)
+				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"u1", "u2"}, nil)
+				rev.On("RemoveAllReviewers", mock.Anything, "pr1").Return(nil)
+				pr.On("SoftDeletePR", mock.Anything, "pr1").Return(nil)
+				hist.On("AddPREvent", mock.Anything, "pr1", "DELETED", mock.MatchedBy(func(d map[string]any) bool {
+					return d["mode"] == models.DeleteModeSoft
+				})).Return(nil)
+			},
+		},
+		{
+			name: "hard delete releases reviewers",
+			mode: models.DeleteModeHard,
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, hist *mocks.PRHistoryRepository) {
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+					PullRequestID: "pr1",
+					Status:        models.StatusMerged,
+				}, nil)
+				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{"u1"}, nil)
+				rev.On("RemoveAllReviewers", mock.Anything, "pr1").Return(nil)
+				pr.On("DeletePR", mock.Anything, "pr1").Return(nil)
+				hist.On("AddPREvent", mock.Anything, "pr1", "DELETED", mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "PR not found",
+			mode: models.DeleteModeSoft,
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, hist *mocks.PRHistoryRepository) {
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(nil, errors.New("no rows"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "history error",
+			mode: models.DeleteModeHard,
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, hist *mocks.PRHistoryRepository) {
+				pr.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1"}, nil)
+				rev.On("GetPRReviewers", mock.Anything, "pr1").Return([]string{}, nil)
+				rev.On("RemoveAllReviewers", mock.Anything, "pr1").Return(nil)
+				pr.On("DeletePR", mock.Anything, "pr1").Return(nil)
+				hist.On("AddPREvent", mock.Anything, "pr1", "DELETED", mock.Anything).Return(errors.New("fail"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewPullRequestRepository(t)
+			revRepo := mocks.NewReviewRepository(t)
+			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			tt.setup(prRepo, revRepo, histRepo)
+
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, tt.mode, nil)
 
-			_, _, err := svc.ReassignReviewer(context.Background(), "pr1", "old")
+			res, err := svc.DeletePR(context.Background(), "pr1", 0)
 
 			if tt.wantErr {
 				require.Error(t, err)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "pr1", res.PullRequestID)
+			}
+		})
+	}
+}
+
+func TestReplaceReviewer(t *testing.T) {
+	tests := []struct {
+		name     string
+		assigned []string
+		newID    string
+		setup    func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository)
+		wantErr  string
+	}{
+		{
+			name:     "hand over to new reviewer",
+			assigned: []string{"old", "u2"},
+			newID:    "u3",
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository) {
+				rev.On("RemoveReviewer", mock.Anything, "pr1", "old").Return(nil)
+				rev.On("AddReviewer", mock.Anything, "pr1", "u3").Return(nil)
+				pr.On("BumpVersion", mock.Anything, "pr1").Return(nil)
+				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1"}, nil)
+			},
+		},
+		{
+			name:     "target already assigned",
+			assigned: []string{"old", "u2"},
+			newID:    "u2",
+			setup: func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository) {
+				rev.On("RemoveReviewer", mock.Anything, "pr1", "old").Return(nil)
+				pr.On("BumpVersion", mock.Anything, "pr1").Return(nil)
+				pr.On("GetPR", mock.Anything, "pr1").Return(&models.PullRequest{PullRequestID: "pr1"}, nil)
+			},
+		},
+		{
+			name:     "target is author",
+			assigned: []string{"old"},
+			newID:    "author",
+			wantErr:  "NO_CANDIDATE",
+		},
+		{
+			name:     "old reviewer not assigned",
+			assigned: []string{"u2"},
+			newID:    "u3",
+			wantErr:  "NOT_ASSIGNED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewPullRequestRepository(t)
+			revRepo := mocks.NewReviewRepository(t)
+			teamRepo := mocks.NewTeamInfoRepository(t)
+			histRepo := mocks.NewPRHistoryRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+
+			prRepo.On("GetPRForUpdate", mock.Anything, "pr1").Return(&models.PullRequest{
+				PullRequestID: "pr1",
+				AuthorID:      "author",
+				Status:        models.StatusOpen,
+			}, nil)
+			revRepo.On("GetPRReviewers", mock.Anything, "pr1").Return(tt.assigned, nil)
+			if tt.setup != nil {
+				tt.setup(prRepo, revRepo)
+			}
+
+			svc := service.NewPullRequestService(prRepo, revRepo, teamRepo, histRepo, txMgr, models.DeleteModeSoft, nil)
+
+			_, err := svc.ReplaceReviewer(context.Background(), "pr1", "old", tt.newID)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
 			} else {
 				require.NoError(t, err)
 			}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"pull-request-service/internal/models"
+)
+
+type StatsRepository interface {
+	GetCycleTime(ctx context.Context, q *models.CycleTimeQuery) ([]*models.CycleTimeStats, error)
+	GetMemberAssignments(ctx context.Context, q *models.FairnessQuery) ([]*models.MemberAssignments, error)
+	LockReviewerCounters(ctx context.Context) error
+	DiffReviewerCounters(ctx context.Context) ([]*models.CounterDrift, error)
+	RebuildReviewerCounters(ctx context.Context) error
+	GetOpenPullRequests(ctx context.Context, teamName string) ([]*models.OpenPullRequest, error)
+	GetOverdueReviews(ctx context.Context, teamName string, assignedBefore time.Time) ([]*models.OverdueReview, error)
+	GetDailyActivity(ctx context.Context, teamName string, since time.Time) ([]*models.DailyActivity, error)
+	TakeLoadSnapshot(ctx context.Context, day time.Time) (int, error)
+	GetLoadTrend(ctx context.Context, q *models.LoadTrendQuery) ([]*models.LoadTrendPoint, error)
+}
+
+type StatsService struct {
+	statsRepo StatsRepository
+	txMgr     TransactionManager
+}
+
+func NewStatsService(r StatsRepository, txMgr TransactionManager) *StatsService {
+	return &StatsService{
+		statsRepo: r,
+		txMgr:     txMgr,
+	}
+}
+
+// GetCycleTime reports time-to-merge and time-to-first-review percentiles, grouped by team unless asked otherwise.
+func (s *StatsService) GetCycleTime(ctx context.Context, q *models.CycleTimeQuery) (*models.CycleTimeReport, error) {
+	if q.GroupBy == "" {
+		q.GroupBy = models.CycleTimeByTeam
+	}
+
+	groups, err := s.statsRepo.GetCycleTime(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("getting cycle time: %w", err)
+	}
+
+	return &models.CycleTimeReport{
+		GroupBy: q.GroupBy,
+		From:    q.From,
+		To:      q.To,
+		Groups:  groups,
+	}, nil
+}
+
+// fairnessOutlierSigmas is the deviation, in population standard deviations, past which a member
+// counts as an outlier. No member of an n-member team can deviate by more than sqrt(n-1) sigmas, so
+// a 2σ cut-off would never fire for teams of five or fewer; 1.5σ is reachable from four members up.
+const fairnessOutlierSigmas = 1.5
+
+// GetFairness reports, per team, how evenly assignments were spread across its members.
+func (s *StatsService) GetFairness(ctx context.Context, q *models.FairnessQuery) (*models.FairnessReport, error) {
+	assignments, err := s.statsRepo.GetMemberAssignments(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("getting member assignments: %w", err)
+	}
+
+	report := &models.FairnessReport{
+		From:  q.From,
+		To:    q.To,
+		Teams: []*models.TeamFairness{},
+	}
+
+	// rows come ordered by team, so each team is a contiguous run
+	for start := 0; start < len(assignments); {
+		end := start
+		for end < len(assignments) && assignments[end].TeamName == assignments[start].TeamName {
+			end++
+		}
+		report.Teams = append(report.Teams, teamFairness(assignments[start].TeamName, assignments[start:end]))
+		start = end
+	}
+
+	return report, nil
+}
+
+func teamFairness(teamName string, members []*models.MemberAssignments) *models.TeamFairness {
+	tf := &models.TeamFairness{
+		TeamName: teamName,
+		Outliers: []*models.FairnessOutlier{},
+		Members:  members,
+	}
+
+	counts := make([]float64, len(members))
+	for i, m := range members {
+		counts[i] = float64(m.Assignments)
+		tf.Assignments += m.Assignments
+	}
+
+	n := float64(len(counts))
+	tf.Mean = float64(tf.Assignments) / n
+
+	var variance float64
+	for _, c := range counts {
+		variance += (c - tf.Mean) * (c - tf.Mean)
+	}
+	tf.StdDev = math.Sqrt(variance / n)
+
+	slices.Sort(counts)
+	tf.Gini = gini(counts)
+	if minCount := counts[0]; minCount > 0 {
+		ratio := counts[len(counts)-1] / minCount
+		tf.MaxMinRatio = &ratio
+	}
+
+	if tf.StdDev == 0 {
+		return tf
+	}
+	for _, m := range members {
+		deviation := (float64(m.Assignments) - tf.Mean) / tf.StdDev
+		if math.Abs(deviation) > fairnessOutlierSigmas {
+			tf.Outliers = append(tf.Outliers, &models.FairnessOutlier{
+				UserID:      m.UserID,
+				Username:    m.Username,
+				Assignments: m.Assignments,
+				Deviation:   deviation,
+			})
+		}
+	}
+
+	return tf
+}
+
+// gini expects counts sorted ascending: 0 means a perfectly even spread, (n-1)/n means one member got everything.
+func gini(sorted []float64) float64 {
+	var sum, weighted float64
+	for i, c := range sorted {
+		sum += c
+		weighted += float64(i+1) * c
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(sorted))
+	return 2*weighted/(n*sum) - (n+1)/n
+}
+
+// CheckReviewerCounters compares the reviewer counters with pr_reviewers and, if rebuild is set,
+// recomputes the whole table. Counter updates wait while the check runs.
+func (s *StatsService) CheckReviewerCounters(ctx context.Context, rebuild bool) (*models.CounterCheckReport, error) {
+	report := &models.CounterCheckReport{}
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.statsRepo.LockReviewerCounters(txCtx); err != nil {
+			return err
+		}
+
+		drift, err := s.statsRepo.DiffReviewerCounters(txCtx)
+		if err != nil {
+			return err
+		}
+		report.Drift = drift
+		report.Consistent = len(drift) == 0
+
+		if !rebuild {
+			return nil
+		}
+		if err := s.statsRepo.RebuildReviewerCounters(txCtx); err != nil {
+			return err
+		}
+		report.Rebuilt = true
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("checking reviewer counters: %w", err)
+	}
+
+	return report, nil
+}
+
+func (s *StatsService) GetOpenPullRequests(ctx context.Context, teamName string) ([]*models.OpenPullRequest, error) {
+	prs, err := s.statsRepo.GetOpenPullRequests(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("getting open PRs: %w", err)
+	}
+	return prs, nil
+}
+
+// GetOverdueReviews returns reviews on open PRs that have waited for pickup longer than olderThan.
+func (s *StatsService) GetOverdueReviews(ctx context.Context, teamName string, olderThan time.Duration) ([]*models.OverdueReview, error) {
+	reviews, err := s.statsRepo.GetOverdueReviews(ctx, teamName, time.Now().UTC().Add(-olderThan))
+	if err != nil {
+		return nil, fmt.Errorf("getting overdue reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// GetDailyActivity returns the team's activity for the last days UTC days, today included.
+func (s *StatsService) GetDailyActivity(ctx context.Context, teamName string, days int) ([]*models.DailyActivity, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, -(days - 1))
+
+	activity, err := s.statsRepo.GetDailyActivity(ctx, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("getting daily activity: %w", err)
+	}
+	return activity, nil
+}
+
+const defaultTrendDays = 30
+
+// TakeLoadSnapshot stores today's (UTC) review load of every user and returns how many users were recorded.
+func (s *StatsService) TakeLoadSnapshot(ctx context.Context) (int, error) {
+	n, err := s.statsRepo.TakeLoadSnapshot(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("taking load snapshot: %w", err)
+	}
+	return n, nil
+}
+
+// GetLoadTrend returns the daily load series of a user or a team, for the last 30 days unless a range is given.
+func (s *StatsService) GetLoadTrend(ctx context.Context, q *models.LoadTrendQuery) (*models.LoadTrend, error) {
+	if q.From == nil && q.To == nil {
+		from := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -(defaultTrendDays - 1))
+		q.From = &from
+	}
+
+	points, err := s.statsRepo.GetLoadTrend(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("getting load trend: %w", err)
+	}
+
+	return &models.LoadTrend{
+		UserID:   q.UserID,
+		TeamName: q.TeamName,
+		From:     q.From,
+		To:       q.To,
+		Points:   points,
+	}, nil
+}
@@ -0,0 +1,299 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pull-request-service/internal/models"
+	"pull-request-service/internal/service"
+	"pull-request-service/internal/service/mocks"
+)
+
+func TestStatsService_GetCycleTime(t *testing.T) {
+	ctx := context.Background()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	p50 := 3600.0
+	groups := []*models.CycleTimeStats{{Key: "backend", PRsCreated: 2, PRsMerged: 1, TimeToMerge: models.Percentiles{P50: &p50}}}
+
+	tests := []struct {
+		name        string
+		query       *models.CycleTimeQuery
+		wantGroupBy models.CycleTimeGroupBy
+		err         error
+	}{
+		{name: "defaults to team", query: &models.CycleTimeQuery{From: &from}, wantGroupBy: models.CycleTimeByTeam},
+		{name: "by week", query: &models.CycleTimeQuery{GroupBy: models.CycleTimeByWeek}, wantGroupBy: models.CycleTimeByWeek},
+		{name: "repo error", query: &models.CycleTimeQuery{}, err: errors.New("fail")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewStatsRepository(t)
+			repo.EXPECT().GetCycleTime(mock.Anything, mock.MatchedBy(func(q *models.CycleTimeQuery) bool {
+				return q.GroupBy != ""
+			})).Return(groups, tt.err)
+
+			svc := service.NewStatsService(repo, mocks.NewTransactionManager(t))
+
+			report, err := svc.GetCycleTime(ctx, tt.query)
+			if tt.err != nil {
+				require.Error(t, err)
+				assert.Nil(t, report)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGroupBy, report.GroupBy)
+			assert.Equal(t, tt.query.From, report.From)
+			assert.Equal(t, groups, report.Groups)
+		})
+	}
+}
+
+func TestStatsService_GetFairness(t *testing.T) {
+	ctx := context.Background()
+
+	member := func(team, userID string, n int) *models.MemberAssignments {
+		return &models.MemberAssignments{TeamName: team, UserID: userID, Username: userID, Assignments: n}
+	}
+	ratio := func(v float64) *float64 { return &v }
+
+	skewed := []*models.MemberAssignments{member("backend", "u10", 20)}
+	for i := 1; i <= 9; i++ {
+		skewed = append(skewed, member("backend", fmt.Sprintf("u%d", i), 1))
+	}
+
+	tests := []struct {
+		name         string
+		assignments  []*models.MemberAssignments
+		err          error
+		wantTeams    int
+		wantGini     float64
+		wantRatio    *float64
+		wantOutliers []string
+	}{
+		{
+			name:         "even spread",
+			assignments:  []*models.MemberAssignments{member("backend", "u1", 3), member("backend", "u2", 3), member("backend", "u3", 3)},
+			wantTeams:    1,
+			wantGini:     0,
+			wantRatio:    ratio(1.0),
+			wantOutliers: []string{},
+		},
+		{
+			name:         "one member takes most reviews",
+			assignments:  skewed,
+			wantTeams:    1,
+			wantGini:     490.0/290.0 - 1.1,
+			wantRatio:    ratio(20.0),
+			wantOutliers: []string{"u10"},
+		},
+		{
+			name: "small team with one overloaded member",
+			assignments: []*models.MemberAssignments{
+				member("backend", "u1", 2), member("backend", "u2", 2), member("backend", "u3", 2), member("backend", "u4", 10),
+			},
+			wantTeams:    1,
+			wantGini:     0.375,
+			wantRatio:    ratio(5.0),
+			wantOutliers: []string{"u4"},
+		},
+		{
+			name:         "idle member leaves ratio undefined",
+			assignments:  []*models.MemberAssignments{member("backend", "u1", 0), member("backend", "u2", 4), member("frontend", "u3", 1)},
+			wantTeams:    2,
+			wantGini:     0.5,
+			wantOutliers: []string{},
+		},
+		{
+			name:        "no members",
+			assignments: nil,
+			wantTeams:   0,
+		},
+		{
+			name: "repo error",
+			err:  errors.New("fail"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewStatsRepository(t)
+			q := &models.FairnessQuery{TeamName: "backend"}
+			repo.EXPECT().GetMemberAssignments(mock.Anything, q).Return(tt.assignments, tt.err)
+
+			svc := service.NewStatsService(repo, mocks.NewTransactionManager(t))
+
+			report, err := svc.GetFairness(ctx, q)
+			if tt.err != nil {
+				require.Error(t, err)
+				assert.Nil(t, report)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, report.Teams, tt.wantTeams)
+			if tt.wantTeams == 0 {
+				return
+			}
+
+			team := report.Teams[0]
+			assert.Equal(t, "backend", team.TeamName)
+			assert.InDelta(t, tt.wantGini, team.Gini, 1e-9)
+			if tt.wantRatio == nil {
+				assert.Nil(t, team.MaxMinRatio)
+			} else {
+				require.NotNil(t, team.MaxMinRatio)
+				assert.InDelta(t, *tt.wantRatio, *team.MaxMinRatio, 1e-9)
+			}
+
+			outliers := []string{}
+			for _, o := range team.Outliers {
+				outliers = append(outliers, o.UserID)
+			}
+			assert.Equal(t, tt.wantOutliers, outliers)
+		})
+	}
+}
+
+func TestStatsService_CheckReviewerCounters(t *testing.T) {
+	ctx := context.Background()
+	drift := []*models.CounterDrift{{
+		UserID: "u1",
+		Stored: models.ReviewCounters{Reviews: 3, OpenReviews: 3},
+		Actual: models.ReviewCounters{Reviews: 2, OpenReviews: 1, MergedReviews: 1},
+	}}
+
+	tests := []struct {
+		name           string
+		rebuild        bool
+		drift          []*models.CounterDrift
+		rebuildErr     error
+		wantConsistent bool
+		wantRebuilt    bool
+		wantErr        bool
+	}{
+		{name: "consistent", drift: []*models.CounterDrift{}, wantConsistent: true},
+		{name: "drift is reported without rebuilding", drift: drift},
+		{name: "rebuild after drift", rebuild: true, drift: drift, wantRebuilt: true},
+		{name: "rebuild error", rebuild: true, drift: drift, rebuildErr: errors.New("fail"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewStatsRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+			expectTx(txMgr)
+
+			repo.EXPECT().LockReviewerCounters(mock.Anything).Return(nil)
+			repo.EXPECT().DiffReviewerCounters(mock.Anything).Return(tt.drift, nil)
+			if tt.rebuild {
+				repo.EXPECT().RebuildReviewerCounters(mock.Anything).Return(tt.rebuildErr)
+			}
+
+			svc := service.NewStatsService(repo, txMgr)
+
+			report, err := svc.CheckReviewerCounters(ctx, tt.rebuild)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, report)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantConsistent, report.Consistent)
+			assert.Equal(t, tt.wantRebuilt, report.Rebuilt)
+			assert.Equal(t, tt.drift, report.Drift)
+		})
+	}
+}
+
+func TestStatsService_DashboardQueries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("daily activity starts days-1 days before today", func(t *testing.T) {
+		repo := mocks.NewStatsRepository(t)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		activity := []*models.DailyActivity{{Day: today, Assigned: 2}}
+
+		repo.EXPECT().GetDailyActivity(mock.Anything, "backend", today.AddDate(0, 0, -29)).Return(activity, nil)
+
+		got, err := service.NewStatsService(repo, mocks.NewTransactionManager(t)).GetDailyActivity(ctx, "backend", 30)
+		require.NoError(t, err)
+		assert.Equal(t, activity, got)
+	})
+
+	t.Run("overdue reviews are cut off at now minus threshold", func(t *testing.T) {
+		repo := mocks.NewStatsRepository(t)
+		before := time.Now().UTC().Add(-24 * time.Hour)
+
+		repo.EXPECT().GetOverdueReviews(mock.Anything, "backend", mock.MatchedBy(func(cutoff time.Time) bool {
+			return !cutoff.Before(before) && cutoff.Before(before.Add(time.Minute))
+		})).Return([]*models.OverdueReview{}, nil)
+
+		_, err := service.NewStatsService(repo, mocks.NewTransactionManager(t)).GetOverdueReviews(ctx, "backend", 24*time.Hour)
+		require.NoError(t, err)
+	})
+
+	t.Run("open PRs repo error", func(t *testing.T) {
+		repo := mocks.NewStatsRepository(t)
+		repo.EXPECT().GetOpenPullRequests(mock.Anything, "backend").Return(nil, errors.New("fail"))
+
+		prs, err := service.NewStatsService(repo, mocks.NewTransactionManager(t)).GetOpenPullRequests(ctx, "backend")
+		require.Error(t, err)
+		assert.Nil(t, prs)
+	})
+}
+
+func TestStatsService_GetLoadTrend(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := []*models.LoadTrendPoint{{Day: from, Members: 3, OpenReviews: 4, MaxOpenReviews: 2, CompletedReviews: 10}}
+
+	tests := []struct {
+		name        string
+		query       *models.LoadTrendQuery
+		wantDefault bool
+		err         error
+	}{
+		{name: "explicit range", query: &models.LoadTrendQuery{TeamName: "backend", From: &from}},
+		{name: "defaults to last 30 days", query: &models.LoadTrendQuery{UserID: "u1"}, wantDefault: true},
+		{name: "repo error", query: &models.LoadTrendQuery{UserID: "u1"}, err: errors.New("fail")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewStatsRepository(t)
+			repo.EXPECT().GetLoadTrend(mock.Anything, tt.query).Return(points, tt.err)
+
+			svc := service.NewStatsService(repo, mocks.NewTransactionManager(t))
+
+			trend, err := svc.GetLoadTrend(ctx, tt.query)
+			if tt.err != nil {
+				require.Error(t, err)
+				assert.Nil(t, trend)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, points, trend.Points)
+			assert.Equal(t, tt.query.UserID, trend.UserID)
+			assert.Equal(t, tt.query.TeamName, trend.TeamName)
+			require.NotNil(t, trend.From)
+			if tt.wantDefault {
+				today := time.Now().UTC().Truncate(24 * time.Hour)
+				assert.Equal(t, today.AddDate(0, 0, -29), *trend.From)
+			} else {
+				assert.Equal(t, from, *trend.From)
+			}
+		})
+	}
+}
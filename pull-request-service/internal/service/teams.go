@@ -2,41 +2,70 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"pull-request-service/internal/models"
 )
 
 type TeamsRepository interface {
-	InsertTeam(ctx context.Context, teamName string) error
+	InsertTeam(ctx context.Context, teamName, parentTeamName string) (bool, error)
 	GetTeam(ctx context.Context, teamName string) (*models.Team, error)
 	GetUserTeam(ctx context.Context, userID string) (string, error)
 	GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]string, error)
+	TeamExists(ctx context.Context, teamName string) (bool, error)
+	RenameTeam(ctx context.Context, teamName, newTeamName string) error
+	DeleteTeam(ctx context.Context, teamName string) error
+	CountMembers(ctx context.Context, teamName string) (int, error)
+	MoveMembers(ctx context.Context, teamName, newTeamName string) error
+	GetParentTeam(ctx context.Context, teamName string) (string, error)
+	GetChildTeams(ctx context.Context, teamName string) ([]string, error)
+	SetParentTeam(ctx context.Context, teamName, parentTeamName string) error
+	GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error)
+}
+
+type TeamWorkloadRepository interface {
+	GetMembersWorkload(ctx context.Context, userIDs []string) (map[string]*models.MemberWorkload, error)
 }
 
 type TeamUsersRepository interface {
 	UpsertUser(ctx context.Context, userID, username, teamName string, isActive bool) error
+	GetUsers(ctx context.Context, userIDs []string) ([]*models.User, error)
+	DetachUser(ctx context.Context, userID string) error
 }
 
 type TeamsService struct {
-	teamsRepo TeamsRepository
-	usersRepo TeamUsersRepository
-	txMgr     TransactionManager
+	teamsRepo    TeamsRepository
+	usersRepo    TeamUsersRepository
+	workloadRepo TeamWorkloadRepository
+	txMgr        TransactionManager
 }
 
-func NewTeamService(t TeamsRepository, u TeamUsersRepository, txMgr TransactionManager) *TeamsService {
+func NewTeamService(t TeamsRepository, u TeamUsersRepository, w TeamWorkloadRepository, txMgr TransactionManager) *TeamsService {
 	return &TeamsService{
-		teamsRepo: t,
-		usersRepo: u,
-		txMgr:     txMgr,
+		teamsRepo:    t,
+		usersRepo:    u,
+		workloadRepo: w,
+		txMgr:        txMgr,
 	}
 }
 
+// AddTeam creates a new team with its members and fails with TEAM_EXISTS if the team already exists.
 func (s *TeamsService) AddTeam(ctx context.Context, team *models.Team) error {
-	return s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		if err := s.teamsRepo.InsertTeam(txCtx, team.TeamName); err != nil {
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if team.ParentTeamName != "" {
+			if err := s.checkParent(txCtx, team.TeamName, team.ParentTeamName); err != nil {
+				return err
+			}
+		}
+
+		created, err := s.teamsRepo.InsertTeam(txCtx, team.TeamName, team.ParentTeamName)
+		if err != nil {
 			return fmt.Errorf("inserting team: %w", err)
 		}
+		if !created {
+			return errors.New("TEAM_EXISTS")
+		}
 
 		for _, member := range team.Members {
 			if err := s.usersRepo.UpsertUser(txCtx, member.UserID, member.Username, team.TeamName, member.IsActive); err != nil {
@@ -46,6 +75,283 @@ func (s *TeamsService) AddTeam(ctx context.Context, team *models.Team) error {
 
 		return nil
 	})
+
+	if err != nil {
+		switch err.Error() {
+		case "TEAM_EXISTS":
+			return fmt.Errorf("error: code: TEAM_EXISTS, message: team_name already exists")
+		case "NOT_FOUND":
+			return fmt.Errorf("error: code: NOT_FOUND, message: parent team not found")
+		case "INVALID_PARENT":
+			return fmt.Errorf("error: code: INVALID_PARENT, message: parent team would create a cycle")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SyncTeam declaratively brings the team to the given state: the team is created if missing, listed
+// members are upserted into it as their primary team and primary members that are not listed are
+// detached and deactivated. The report describes what happened to each member.
+func (s *TeamsService) SyncTeam(ctx context.Context, team *models.Team) (*models.TeamSyncReport, error) {
+	report := &models.TeamSyncReport{TeamName: team.TeamName, Members: []models.TeamSyncMemberChange{}}
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if team.ParentTeamName != "" {
+			if err := s.checkParent(txCtx, team.TeamName, team.ParentTeamName); err != nil {
+				return err
+			}
+		}
+
+		created, err := s.teamsRepo.InsertTeam(txCtx, team.TeamName, team.ParentTeamName)
+		if err != nil {
+			return fmt.Errorf("inserting team: %w", err)
+		}
+		report.Created = created
+
+		var currentMembers []models.TeamMember
+		if !created {
+			current, err := s.teamsRepo.GetTeam(txCtx, team.TeamName)
+			if err != nil {
+				return fmt.Errorf("getting team: %w", err)
+			}
+			currentMembers = current.Members
+		}
+
+		userIDs := make([]string, 0, len(team.Members))
+		for _, m := range team.Members {
+			userIDs = append(userIDs, m.UserID)
+		}
+
+		users, err := s.usersRepo.GetUsers(txCtx, userIDs)
+		if err != nil {
+			return fmt.Errorf("getting users: %w", err)
+		}
+		existing := make(map[string]*models.User, len(users))
+		for _, u := range users {
+			existing[u.UserID] = u
+		}
+
+		listed := make(map[string]bool, len(team.Members))
+		for _, m := range team.Members {
+			listed[m.UserID] = true
+
+			change := models.TeamSyncMemberChange{UserID: m.UserID}
+			u, ok := existing[m.UserID]
+			switch {
+			case !ok:
+				change.Status = models.TeamSyncAdded
+			case u.TeamName != team.TeamName:
+				change.Status = models.TeamSyncJoined
+				change.FromTeam = u.TeamName
+			case u.Username != m.Username || u.IsActive != m.IsActive:
+				change.Status = models.TeamSyncUpdated
+			default:
+				change.Status = models.TeamSyncUnchanged
+			}
+			report.Members = append(report.Members, change)
+
+			if change.Status == models.TeamSyncUnchanged {
+				continue
+			}
+			if err := s.usersRepo.UpsertUser(txCtx, m.UserID, m.Username, team.TeamName, m.IsActive); err != nil {
+				return fmt.Errorf("upserting user %s: %w", m.UserID, err)
+			}
+		}
+
+		for _, m := range currentMembers {
+			if listed[m.UserID] || m.IsPrimary == nil || !*m.IsPrimary {
+				continue
+			}
+			if err := s.usersRepo.DetachUser(txCtx, m.UserID); err != nil {
+				return fmt.Errorf("detaching user %s: %w", m.UserID, err)
+			}
+			report.Members = append(report.Members, models.TeamSyncMemberChange{UserID: m.UserID, Status: models.TeamSyncRemoved})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: parent team not found")
+		case "INVALID_PARENT":
+			return nil, fmt.Errorf("error: code: INVALID_PARENT, message: parent team would create a cycle")
+		default:
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// checkParent makes sure parentTeamName exists and is not teamName itself or one of its descendants.
+func (s *TeamsService) checkParent(ctx context.Context, teamName, parentTeamName string) error {
+	exists, err := s.teamsRepo.TeamExists(ctx, parentTeamName)
+	if err != nil {
+		return fmt.Errorf("checking parent team: %w", err)
+	}
+	if !exists {
+		return errors.New("NOT_FOUND")
+	}
+
+	visited := make(map[string]bool)
+	for team := parentTeamName; team != ""; {
+		if team == teamName || visited[team] {
+			return errors.New("INVALID_PARENT")
+		}
+		visited[team] = true
+
+		team, err = s.teamsRepo.GetParentTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("getting parent team: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateTeam renames a team and/or moves it under another parent; members and sub-teams
+// follow a rename through the ON UPDATE CASCADE on users.team_name and teams.parent_team_name.
+func (s *TeamsService) UpdateTeam(ctx context.Context, req *models.UpdateTeamRequest) (*models.Team, error) {
+	var result *models.Team
+
+	newTeamName := req.NewTeamName
+	if newTeamName == "" {
+		newTeamName = req.TeamName
+	}
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		exists, err := s.teamsRepo.TeamExists(txCtx, req.TeamName)
+		if err != nil {
+			return fmt.Errorf("checking team: %w", err)
+		}
+		if !exists {
+			return errors.New("NOT_FOUND")
+		}
+
+		if req.ParentTeamName != nil {
+			if *req.ParentTeamName != "" {
+				if err := s.checkParent(txCtx, req.TeamName, *req.ParentTeamName); err != nil {
+					return err
+				}
+			}
+
+			if err := s.teamsRepo.SetParentTeam(txCtx, req.TeamName, *req.ParentTeamName); err != nil {
+				return fmt.Errorf("setting parent team: %w", err)
+			}
+		}
+
+		if newTeamName != req.TeamName {
+			taken, err := s.teamsRepo.TeamExists(txCtx, newTeamName)
+			if err != nil {
+				return fmt.Errorf("checking new team name: %w", err)
+			}
+			if taken {
+				return errors.New("TEAM_EXISTS")
+			}
+
+			if err := s.teamsRepo.RenameTeam(txCtx, req.TeamName, newTeamName); err != nil {
+				return fmt.Errorf("renaming team: %w", err)
+			}
+		}
+
+		result, err = s.teamsRepo.GetTeam(txCtx, newTeamName)
+		if err != nil {
+			return fmt.Errorf("getting updated team: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: team not found")
+		case "TEAM_EXISTS":
+			return nil, fmt.Errorf("error: code: TEAM_EXISTS, message: team_name already exists")
+		case "INVALID_PARENT":
+			return nil, fmt.Errorf("error: code: INVALID_PARENT, message: parent team would create a cycle")
+		default:
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteTeam removes a team. Members are moved to reassignTo when it is given; without it only a team
+// with no members can be deleted. Teams with sub-teams are never deleted.
+func (s *TeamsService) DeleteTeam(ctx context.Context, teamName, reassignTo string) error {
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if reassignTo == teamName {
+			return errors.New("INVALID_REASSIGN")
+		}
+
+		exists, err := s.teamsRepo.TeamExists(txCtx, teamName)
+		if err != nil {
+			return fmt.Errorf("checking team: %w", err)
+		}
+		if !exists {
+			return errors.New("NOT_FOUND")
+		}
+
+		children, err := s.teamsRepo.GetChildTeams(txCtx, teamName)
+		if err != nil {
+			return fmt.Errorf("getting child teams: %w", err)
+		}
+		if len(children) > 0 {
+			return errors.New("TEAM_HAS_SUBTEAMS")
+		}
+
+		if reassignTo == "" {
+			members, err := s.teamsRepo.CountMembers(txCtx, teamName)
+			if err != nil {
+				return fmt.Errorf("counting members: %w", err)
+			}
+			if members > 0 {
+				return errors.New("TEAM_NOT_EMPTY")
+			}
+		} else {
+			exists, err := s.teamsRepo.TeamExists(txCtx, reassignTo)
+			if err != nil {
+				return fmt.Errorf("checking reassign team: %w", err)
+			}
+			if !exists {
+				return errors.New("NOT_FOUND")
+			}
+
+			if err := s.teamsRepo.MoveMembers(txCtx, teamName, reassignTo); err != nil {
+				return fmt.Errorf("moving members: %w", err)
+			}
+		}
+
+		if err := s.teamsRepo.DeleteTeam(txCtx, teamName); err != nil {
+			return fmt.Errorf("deleting team: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return fmt.Errorf("error: code: NOT_FOUND, message: team not found")
+		case "INVALID_REASSIGN":
+			return fmt.Errorf("error: code: INVALID_REASSIGN, message: reassign_to must differ from team_name")
+		case "TEAM_HAS_SUBTEAMS":
+			return fmt.Errorf("error: code: TEAM_HAS_SUBTEAMS, message: team has sub-teams")
+		case "TEAM_NOT_EMPTY":
+			return fmt.Errorf("error: code: TEAM_NOT_EMPTY, message: team still has members")
+		default:
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *TeamsService) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
@@ -56,6 +362,96 @@ func (s *TeamsService) GetTeam(ctx context.Context, teamName string) (*models.Te
 	return team, nil
 }
 
+// GetTeamSubtree returns the team with its sub-teams nested recursively.
+func (s *TeamsService) GetTeamSubtree(ctx context.Context, teamName string) (*models.Team, error) {
+	var result *models.Team
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		result, err = s.buildSubtree(txCtx, teamName, make(map[string]bool))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *TeamsService) buildSubtree(ctx context.Context, teamName string, visited map[string]bool) (*models.Team, error) {
+	visited[teamName] = true
+
+	team, err := s.teamsRepo.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("getting team: %w", err)
+	}
+
+	children, err := s.teamsRepo.GetChildTeams(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("getting child teams: %w", err)
+	}
+
+	for _, child := range children {
+		if visited[child] {
+			continue
+		}
+		subTeam, err := s.buildSubtree(ctx, child, visited)
+		if err != nil {
+			return nil, err
+		}
+		team.SubTeams = append(team.SubTeams, subTeam)
+	}
+
+	return team, nil
+}
+
+// AddWorkload fills the workload of every member of the team and its sub-teams using a single query.
+func (s *TeamsService) AddWorkload(ctx context.Context, team *models.Team) error {
+	var userIDs []string
+	var collect func(t *models.Team)
+	collect = func(t *models.Team) {
+		for _, m := range t.Members {
+			userIDs = append(userIDs, m.UserID)
+		}
+		for _, sub := range t.SubTeams {
+			collect(sub)
+		}
+	}
+	collect(team)
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	workload, err := s.workloadRepo.GetMembersWorkload(ctx, userIDs)
+	if err != nil {
+		return fmt.Errorf("getting members workload: %w", err)
+	}
+
+	var fill func(t *models.Team)
+	fill = func(t *models.Team) {
+		for i := range t.Members {
+			if w, ok := workload[t.Members[i].UserID]; ok {
+				t.Members[i].Workload = w
+			}
+		}
+		for _, sub := range t.SubTeams {
+			fill(sub)
+		}
+	}
+	fill(team)
+
+	return nil
+}
+
+func (s *TeamsService) GetTeamReviewsStats(ctx context.Context) ([]*models.TeamReviewStats, error) {
+	stats, err := s.teamsRepo.GetTeamReviewsStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting team reviews stats: %w", err)
+	}
+	return stats, nil
+}
+
 func (s *TeamsService) GetUserTeam(ctx context.Context, userID string) (string, error) {
 	teamName, err := s.teamsRepo.GetUserTeam(ctx, userID)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 func TestTeamsService_AddTeam(t *testing.T) {
 	type fields struct {
 		teamsRepoErr error
+		teamExists   bool
 		userErrAt    int
 		txErr        error
 	}
@@ -44,6 +45,15 @@ func TestTeamsService_AddTeam(t *testing.T) {
 			expectUpserts: 0,
 			wantErr:       true,
 		},
+		{
+			name: "team already exists",
+			fields: fields{
+				teamExists: true,
+			},
+			expectInsert:  true,
+			expectUpserts: 0,
+			wantErr:       true,
+		},
 		{
 			name: "error UpsertUser",
 			fields: fields{
@@ -77,7 +87,7 @@ func TestTeamsService_AddTeam(t *testing.T) {
 			ctx := context.Background()
 
 			teamsRepo := mocks.NewTeamsRepository(t)
-			usersRepo := mocks.NewUsersRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			txMgr.EXPECT().
@@ -91,8 +101,8 @@ func TestTeamsService_AddTeam(t *testing.T) {
 
 			if tt.expectInsert {
 				teamsRepo.EXPECT().
-					InsertTeam(mock.Anything, "backend").
-					Return(tt.fields.teamsRepoErr)
+					InsertTeam(mock.Anything, "backend", "").
+					Return(!tt.fields.teamExists, tt.fields.teamsRepoErr)
 			}
 
 			for i, m := range team.Members {
@@ -116,7 +126,7 @@ func TestTeamsService_AddTeam(t *testing.T) {
 					Return(err)
 			}
 
-			svc := service.NewTeamService(teamsRepo, usersRepo, txMgr)
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
 
 			err := svc.AddTeam(ctx, team)
 
@@ -156,14 +166,14 @@ func TestTeamsService_GetTeam(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 
 			teamsRepo := mocks.NewTeamsRepository(t)
-			usersRepo := mocks.NewUsersRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			teamsRepo.EXPECT().
 				GetTeam(mock.Anything, "backend").
 				Return(tt.retTeam, tt.retErr)
 
-			svc := service.NewTeamService(teamsRepo, usersRepo, txMgr)
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
 
 			team, err := svc.GetTeam(context.Background(), "backend")
 			if tt.wantErr {
@@ -200,14 +210,14 @@ func TestTeamsService_GetUserTeam(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			teamsRepo := mocks.NewTeamsRepository(t)
-			usersRepo := mocks.NewUsersRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			teamsRepo.EXPECT().
 				GetUserTeam(mock.Anything, "u1").
 				Return(tt.retName, tt.retErr)
 
-			svc := service.NewTeamService(teamsRepo, usersRepo, txMgr)
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
 
 			team, err := svc.GetUserTeam(context.Background(), "u1")
 			if tt.wantErr {
@@ -244,14 +254,14 @@ func TestTeamsService_GetActiveTeamMembers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			teamsRepo := mocks.NewTeamsRepository(t)
-			usersRepo := mocks.NewUsersRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
 			txMgr := mocks.NewTransactionManager(t)
 
 			teamsRepo.EXPECT().
 				GetActiveTeamMembers(mock.Anything, "backend", "exclude").
 				Return(tt.retMembers, tt.retErr)
 
-			svc := service.NewTeamService(teamsRepo, usersRepo, txMgr)
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
 
 			res, err := svc.GetActiveTeamMembers(context.Background(), "backend", "exclude")
 			if tt.wantErr {
@@ -264,3 +274,309 @@ func TestTeamsService_GetActiveTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamsService_UpdateTeam(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(teamsRepo *mocks.TeamsRepository)
+		wantErr string
+	}{
+		{
+			name: "success",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "platform").Return(false, nil)
+				teamsRepo.EXPECT().RenameTeam(mock.Anything, "backend", "platform").Return(nil)
+				teamsRepo.EXPECT().GetTeam(mock.Anything, "platform").Return(&models.Team{TeamName: "platform"}, nil)
+			},
+		},
+		{
+			name: "team not found",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(false, nil)
+			},
+			wantErr: "NOT_FOUND",
+		},
+		{
+			name: "new name taken",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "platform").Return(true, nil)
+			},
+			wantErr: "TEAM_EXISTS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamsRepo := mocks.NewTeamsRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			tt.setup(teamsRepo)
+
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
+
+			team, err := svc.UpdateTeam(context.Background(), &models.UpdateTeamRequest{
+				TeamName:    "backend",
+				NewTeamName: "platform",
+			})
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Nil(t, team)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "platform", team.TeamName)
+			}
+		})
+	}
+}
+
+func TestTeamsService_DeleteTeam(t *testing.T) {
+	tests := []struct {
+		name       string
+		reassignTo string
+		setup      func(teamsRepo *mocks.TeamsRepository)
+		wantErr    string
+	}{
+		{
+			name: "empty team",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return(nil, nil)
+				teamsRepo.EXPECT().CountMembers(mock.Anything, "backend").Return(0, nil)
+				teamsRepo.EXPECT().DeleteTeam(mock.Anything, "backend").Return(nil)
+			},
+		},
+		{
+			name: "refused while team has members",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return(nil, nil)
+				teamsRepo.EXPECT().CountMembers(mock.Anything, "backend").Return(1, nil)
+			},
+			wantErr: "TEAM_NOT_EMPTY",
+		},
+		{
+			name:       "refused while team has sub-teams",
+			reassignTo: "platform",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return([]string{"backend-api"}, nil)
+			},
+			wantErr: "TEAM_HAS_SUBTEAMS",
+		},
+		{
+			name:       "reassign to itself",
+			reassignTo: "backend",
+			setup:      func(teamsRepo *mocks.TeamsRepository) {},
+			wantErr:    "INVALID_REASSIGN",
+		},
+		{
+			name:       "members reassigned to another team",
+			reassignTo: "platform",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return(nil, nil)
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "platform").Return(true, nil)
+				teamsRepo.EXPECT().MoveMembers(mock.Anything, "backend", "platform").Return(nil)
+				teamsRepo.EXPECT().DeleteTeam(mock.Anything, "backend").Return(nil)
+			},
+		},
+		{
+			name:       "reassign team not found",
+			reassignTo: "platform",
+			setup: func(teamsRepo *mocks.TeamsRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return(nil, nil)
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "platform").Return(false, nil)
+			},
+			wantErr: "NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamsRepo := mocks.NewTeamsRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			tt.setup(teamsRepo)
+
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
+
+			err := svc.DeleteTeam(context.Background(), "backend", tt.reassignTo)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTeamsService_AddTeamWithParent(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(teamsRepo *mocks.TeamsRepository, usersRepo *mocks.TeamUsersRepository)
+		wantErr string
+	}{
+		{
+			name: "success",
+			setup: func(teamsRepo *mocks.TeamsRepository, usersRepo *mocks.TeamUsersRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetParentTeam(mock.Anything, "backend").Return("engineering", nil)
+				teamsRepo.EXPECT().GetParentTeam(mock.Anything, "engineering").Return("", nil)
+				teamsRepo.EXPECT().InsertTeam(mock.Anything, "squad", "backend").Return(true, nil)
+				usersRepo.EXPECT().UpsertUser(mock.Anything, "u1", "alice", "squad", true).Return(nil)
+			},
+		},
+		{
+			name: "parent not found",
+			setup: func(teamsRepo *mocks.TeamsRepository, usersRepo *mocks.TeamUsersRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(false, nil)
+			},
+			wantErr: "NOT_FOUND",
+		},
+		{
+			name: "parent is a descendant",
+			setup: func(teamsRepo *mocks.TeamsRepository, usersRepo *mocks.TeamUsersRepository) {
+				teamsRepo.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				teamsRepo.EXPECT().GetParentTeam(mock.Anything, "backend").Return("squad", nil)
+			},
+			wantErr: "INVALID_PARENT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamsRepo := mocks.NewTeamsRepository(t)
+			usersRepo := mocks.NewTeamUsersRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			expectTx(txMgr)
+			tt.setup(teamsRepo, usersRepo)
+
+			svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
+
+			err := svc.AddTeam(context.Background(), &models.Team{
+				TeamName:       "squad",
+				ParentTeamName: "backend",
+				Members:        []models.TeamMember{{UserID: "u1", Username: "alice", IsActive: true}},
+			})
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTeamsService_GetTeamSubtree(t *testing.T) {
+	teamsRepo := mocks.NewTeamsRepository(t)
+	usersRepo := mocks.NewTeamUsersRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+	teamsRepo.EXPECT().GetTeam(mock.Anything, "engineering").Return(&models.Team{TeamName: "engineering"}, nil)
+	teamsRepo.EXPECT().GetChildTeams(mock.Anything, "engineering").Return([]string{"backend"}, nil)
+	teamsRepo.EXPECT().GetTeam(mock.Anything, "backend").Return(&models.Team{TeamName: "backend", ParentTeamName: "engineering"}, nil)
+	teamsRepo.EXPECT().GetChildTeams(mock.Anything, "backend").Return([]string{"squad"}, nil)
+	teamsRepo.EXPECT().GetTeam(mock.Anything, "squad").Return(&models.Team{TeamName: "squad", ParentTeamName: "backend"}, nil)
+	teamsRepo.EXPECT().GetChildTeams(mock.Anything, "squad").Return(nil, nil)
+
+	svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
+
+	team, err := svc.GetTeamSubtree(context.Background(), "engineering")
+	require.NoError(t, err)
+	require.Len(t, team.SubTeams, 1)
+	assert.Equal(t, "backend", team.SubTeams[0].TeamName)
+	require.Len(t, team.SubTeams[0].SubTeams, 1)
+	assert.Equal(t, "squad", team.SubTeams[0].SubTeams[0].TeamName)
+}
+
+func TestTeamsService_AddWorkload(t *testing.T) {
+	teamsRepo := mocks.NewTeamsRepository(t)
+	usersRepo := mocks.NewTeamUsersRepository(t)
+	workloadRepo := mocks.NewTeamWorkloadRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	age := 3600.0
+	workloadRepo.EXPECT().GetMembersWorkload(mock.Anything, []string{"u1", "u2", "u3"}).Return(map[string]*models.MemberWorkload{
+		"u1": {OpenReviews: 2, OldestOpenReviewAgeSeconds: &age, ReviewsLast30Days: 5},
+		"u3": {ReviewsLast30Days: 1, IsAbsent: true},
+	}, nil)
+
+	svc := service.NewTeamService(teamsRepo, usersRepo, workloadRepo, txMgr)
+
+	team := &models.Team{
+		TeamName: "engineering",
+		Members:  []models.TeamMember{{UserID: "u1"}, {UserID: "u2"}},
+		SubTeams: []*models.Team{{TeamName: "backend", Members: []models.TeamMember{{UserID: "u3"}}}},
+	}
+
+	err := svc.AddWorkload(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, 2, team.Members[0].Workload.OpenReviews)
+	assert.Equal(t, &age, team.Members[0].Workload.OldestOpenReviewAgeSeconds)
+	assert.Nil(t, team.Members[1].Workload)
+	assert.True(t, team.SubTeams[0].Members[0].Workload.IsAbsent)
+}
+
+func TestTeamsService_SyncTeam(t *testing.T) {
+	primary := true
+	secondary := false
+
+	teamsRepo := mocks.NewTeamsRepository(t)
+	usersRepo := mocks.NewTeamUsersRepository(t)
+	txMgr := mocks.NewTransactionManager(t)
+
+	expectTx(txMgr)
+	teamsRepo.EXPECT().InsertTeam(mock.Anything, "backend", "").Return(false, nil)
+	teamsRepo.EXPECT().GetTeam(mock.Anything, "backend").Return(&models.Team{
+		TeamName: "backend",
+		Members: []models.TeamMember{
+			{UserID: "u1", Username: "alice", IsActive: true, IsPrimary: &primary},
+			{UserID: "u2", Username: "bob", IsActive: true, IsPrimary: &primary},
+			{UserID: "u5", Username: "eve", IsActive: true, IsPrimary: &primary},
+			{UserID: "u6", Username: "frank", IsActive: true, IsPrimary: &secondary},
+		},
+	}, nil)
+	usersRepo.EXPECT().GetUsers(mock.Anything, []string{"u1", "u2", "u3", "u4"}).Return([]*models.User{
+		{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+		{UserID: "u2", Username: "bob", TeamName: "backend", IsActive: true},
+		{UserID: "u3", Username: "carol", TeamName: "frontend", IsActive: true},
+	}, nil)
+	usersRepo.EXPECT().UpsertUser(mock.Anything, "u2", "bob", "backend", false).Return(nil)
+	usersRepo.EXPECT().UpsertUser(mock.Anything, "u3", "carol", "backend", true).Return(nil)
+	usersRepo.EXPECT().UpsertUser(mock.Anything, "u4", "dave", "backend", true).Return(nil)
+	usersRepo.EXPECT().DetachUser(mock.Anything, "u5").Return(nil)
+
+	svc := service.NewTeamService(teamsRepo, usersRepo, mocks.NewTeamWorkloadRepository(t), txMgr)
+
+	report, err := svc.SyncTeam(context.Background(), &models.Team{
+		TeamName: "backend",
+		Members: []models.TeamMember{
+			{UserID: "u1", Username: "alice", IsActive: true},
+			{UserID: "u2", Username: "bob", IsActive: false},
+			{UserID: "u3", Username: "carol", IsActive: true},
+			{UserID: "u4", Username: "dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, report.Created)
+	assert.Equal(t, []models.TeamSyncMemberChange{
+		{UserID: "u1", Status: models.TeamSyncUnchanged},
+		{UserID: "u2", Status: models.TeamSyncUpdated},
+		{UserID: "u3", Status: models.TeamSyncJoined, FromTeam: "frontend"},
+		{UserID: "u4", Status: models.TeamSyncAdded},
+		{UserID: "u5", Status: models.TeamSyncRemoved},
+	}, report.Members)
+}
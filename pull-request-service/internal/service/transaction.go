@@ -4,4 +4,33 @@ import "context"
 
 type TransactionManager interface {
 	WithTransaction(ctx context.Context, fn func(context.Context) error) error
-}
\ No newline at end of file
+}
+
+type commitHooksKey struct{}
+
+// inTransaction works like txMgr.WithTransaction but also runs the hooks queued with onCommit
+// once the outermost transaction has committed. Nested calls leave the hooks to their caller.
+func inTransaction(ctx context.Context, txMgr TransactionManager, fn func(context.Context) error) error {
+	if _, ok := ctx.Value(commitHooksKey{}).(*[]func()); ok {
+		return txMgr.WithTransaction(ctx, fn)
+	}
+
+	var hooks []func()
+	if err := txMgr.WithTransaction(context.WithValue(ctx, commitHooksKey{}, &hooks), fn); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+// onCommit defers hook until the enclosing inTransaction commits; without one it runs hook right away.
+func onCommit(ctx context.Context, hook func()) {
+	if hooks, ok := ctx.Value(commitHooksKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, hook)
+		return
+	}
+	hook()
+}
@@ -2,49 +2,104 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	"pull-request-service/internal/models"
 )
 
 type UsersRepository interface {
-	UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool) error
+	UpdateUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) error
 	GetUser(ctx context.Context, userID string) (*models.User, error)
 	UpsertUser(ctx context.Context, userID, username, teamName string, isActive bool) error
+	UpdateUserTeam(ctx context.Context, userID, teamName string) error
+	RecordTeamChange(ctx context.Context, userID, fromTeam, toTeam string) error
+	GetUserMemberships(ctx context.Context, userID string) ([]models.TeamMembership, error)
+	ReplaceMemberships(ctx context.Context, userID string, memberships []models.TeamMembership) error
+	SearchUsers(ctx context.Context, q *models.ListUsersQuery, limit int) ([]*models.User, error)
+	UpdateUserRole(ctx context.Context, userID string, role models.UserRole) error
+	OffboardUser(ctx context.Context, userID, pseudonym string) error
+	UpdateUserProfile(ctx context.Context, userID string, p *models.UserProfile) error
+	ReactivateExpired(ctx context.Context, now time.Time) ([]string, error)
+	CancelReactivation(ctx context.Context, userID string) error
+}
+
+type UserTeamsRepository interface {
+	TeamExists(ctx context.Context, teamName string) (bool, error)
+}
+
+type ReviewReassigner interface {
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, expectedVersion int) (*models.PullRequest, string, error)
+	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) (*models.PullRequest, error)
 }
 
 type UserReviewRepository interface {
 	GetPRsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
-	GetReviewsStats(ctx context.Context) ([]*models.ReviewerStats, error)
+	GetReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error)
+	GetUserPickupStats(ctx context.Context) ([]*models.UserPickupStats, error)
+	GetTeamPickupStats(ctx context.Context) ([]*models.TeamPickupStats, error)
 }
 
+const defaultUsersPageSize = 50
+
 type UsersService struct {
 	usersRepo  UsersRepository
 	reviewRepo UserReviewRepository
+	teamsRepo  UserTeamsRepository
+	reassigner ReviewReassigner
 	txMgr      TransactionManager
 }
 
-func NewUsersService(u UsersRepository, r UserReviewRepository, txMgr TransactionManager) *UsersService {
+func NewUsersService(
+	u UsersRepository,
+	r UserReviewRepository,
+	t UserTeamsRepository,
+	reassigner ReviewReassigner,
+	txMgr TransactionManager,
+) *UsersService {
 	return &UsersService{
 		usersRepo:  u,
 		reviewRepo: r,
+		teamsRepo:  t,
+		reassigner: reassigner,
 		txMgr:      txMgr,
 	}
 }
 
-func (s *UsersService) SetUserActiveStatus(ctx context.Context, userID string, isActive bool) (*models.User, error) {
+// SetUserActiveStatus changes the active flag. A deactivation with until is temporary:
+// ReactivateExpired turns the user back on once until has passed.
+func (s *UsersService) SetUserActiveStatus(ctx context.Context, userID string, isActive bool, until *time.Time) (*models.User, error) {
+	if until != nil {
+		if isActive {
+			return nil, fmt.Errorf("error: code: INVALID_UNTIL, message: until can only be set when deactivating")
+		}
+		if !until.After(time.Now()) {
+			return nil, fmt.Errorf("error: code: INVALID_UNTIL, message: until must be in the future")
+		}
+		utc := until.UTC()
+		until = &utc
+	}
+
 	var result *models.User
 
 	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		if _, err := s.usersRepo.GetUser(txCtx, userID); err != nil {
+		user, err := s.usersRepo.GetUser(txCtx, userID)
+		if err != nil {
 			return fmt.Errorf("user not found: %w", err)
 		}
+		if isActive && user.OffboardedAt != nil {
+			return errors.New("USER_OFFBOARDED")
+		}
 
-		if err := s.usersRepo.UpdateUserActiveStatus(txCtx, userID, isActive); err != nil {
+		if err := s.usersRepo.UpdateUserActiveStatus(txCtx, userID, isActive, until); err != nil {
 			return fmt.Errorf("updating user status: %w", err)
 		}
 
-		var err error
 		result, err = s.usersRepo.GetUser(txCtx, userID)
 		if err != nil {
 			return fmt.Errorf("getting updated user: %w", err)
@@ -54,6 +109,9 @@ func (s *UsersService) SetUserActiveStatus(ctx context.Context, userID string, i
 	})
 
 	if err != nil {
+		if err.Error() == "USER_OFFBOARDED" {
+			return nil, fmt.Errorf("error: code: USER_OFFBOARDED, message: offboarded user cannot be reactivated")
+		}
 		return nil, err
 	}
 
@@ -68,7 +126,494 @@ func (s *UsersService) GetUserReviews(ctx context.Context, userID string) ([]*mo
 	return prs, nil
 }
 
-func (s *UsersService) GetReviewsStats(ctx context.Context) ([]*models.ReviewerStats, error) {
-	return s.reviewRepo.GetReviewsStats(ctx)
+func (s *UsersService) GetReviewsStats(ctx context.Context, q *models.ReviewsStatsQuery) ([]*models.ReviewerStats, error) {
+	return s.reviewRepo.GetReviewsStats(ctx, q)
+}
+
+func (s *UsersService) GetPickupStats(ctx context.Context) (*models.PickupStats, error) {
+	users, err := s.reviewRepo.GetUserPickupStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting user pickup stats: %w", err)
+	}
+
+	teams, err := s.reviewRepo.GetTeamPickupStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting team pickup stats: %w", err)
+	}
+
+	return &models.PickupStats{Users: users, Teams: teams}, nil
+}
+
+// TransferUser moves a user to another team and handles their open reviews according to the policy:
+// keep leaves them in place, reassign picks replacements from the old team, handover gives them to a specific user.
+func (s *UsersService) TransferUser(ctx context.Context, req *models.TransferUserRequest) (*models.User, []*models.ReviewHandover, error) {
+	var result *models.User
+	var handovers []*models.ReviewHandover
+
+	err := inTransaction(ctx, s.txMgr, func(txCtx context.Context) error {
+		user, err := s.usersRepo.GetUser(txCtx, req.UserID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("USER_NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+		if user.OffboardedAt != nil {
+			return errors.New("USER_OFFBOARDED")
+		}
+
+		exists, err := s.teamsRepo.TeamExists(txCtx, req.TeamName)
+		if err != nil {
+			return fmt.Errorf("checking team: %w", err)
+		}
+		if !exists {
+			return errors.New("NOT_FOUND")
+		}
+
+		if user.TeamName == req.TeamName {
+			result = user
+			return nil
+		}
+
+		handovers, err = s.handleOpenReviews(txCtx, req.UserID, req.OpenReviews, req.HandoverTo)
+		if err != nil {
+			return err
+		}
+
+		if err := s.usersRepo.UpdateUserTeam(txCtx, req.UserID, req.TeamName); err != nil {
+			return fmt.Errorf("updating user team: %w", err)
+		}
+
+		if err := s.usersRepo.RecordTeamChange(txCtx, req.UserID, user.TeamName, req.TeamName); err != nil {
+			return fmt.Errorf("recording team change: %w", err)
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("getting transferred user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "USER_NOT_FOUND":
+			return nil, nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+		case "NOT_FOUND":
+			return nil, nil, fmt.Errorf("error: code: NOT_FOUND, message: team not found")
+		case "USER_OFFBOARDED":
+			return nil, nil, fmt.Errorf("error: code: USER_OFFBOARDED, message: user is offboarded")
+		default:
+			return nil, nil, err
+		}
+	}
+
+	return result, handovers, nil
+}
+
+// handleOpenReviews releases the user's reviews on open PRs. It must run before the user leaves
+// their team, so that reassignment still draws candidates from the old team.
+func (s *UsersService) handleOpenReviews(
+	ctx context.Context,
+	userID string,
+	policy models.OpenReviewsPolicy,
+	handoverTo string,
+) ([]*models.ReviewHandover, error) {
+	if policy == "" || policy == models.OpenReviewsKeep {
+		return nil, nil
+	}
+
+	if policy == models.OpenReviewsHandover {
+		target, err := s.usersRepo.GetUser(ctx, handoverTo)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: handover user not found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting handover user: %w", err)
+		}
+		if !target.IsActive || target.UserID == userID {
+			return nil, fmt.Errorf("error: code: NO_CANDIDATE, message: handover user must be another active user")
+		}
+	}
+
+	prs, err := s.reviewRepo.GetPRsByReviewer(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user reviews: %w", err)
+	}
+
+	var handovers []*models.ReviewHandover
+	for _, pr := range prs {
+		if pr.Status != models.StatusOpen {
+			continue
+		}
+
+		replacedBy := handoverTo
+		if policy == models.OpenReviewsHandover {
+			_, err = s.reassigner.ReplaceReviewer(ctx, pr.PullRequestID, userID, handoverTo)
+		} else {
+			_, replacedBy, err = s.reassigner.ReassignReviewer(ctx, pr.PullRequestID, userID, 0)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("releasing review %s: %w", pr.PullRequestID, err)
+		}
+
+		handovers = append(handovers, &models.ReviewHandover{
+			PullRequestID: pr.PullRequestID,
+			ReplacedBy:    replacedBy,
+		})
+	}
+
+	return handovers, nil
+}
+
+// SetUserTeams replaces the user's team memberships. Exactly one team must be primary;
+// it becomes users.team_name and a change of it is recorded in the membership history.
+func (s *UsersService) SetUserTeams(ctx context.Context, req *models.SetUserTeamsRequest) (*models.User, error) {
+	memberships := make([]models.TeamMembership, 0, len(req.Teams))
+	seen := make(map[string]bool, len(req.Teams))
+	primary := ""
+
+	for _, t := range req.Teams {
+		if seen[t.TeamName] {
+			return nil, fmt.Errorf("error: code: INVALID_MEMBERSHIP, message: team %s is listed more than once", t.TeamName)
+		}
+		seen[t.TeamName] = true
+
+		if t.IsPrimary {
+			if primary != "" {
+				return nil, fmt.Errorf("error: code: INVALID_MEMBERSHIP, message: exactly one primary team is required")
+			}
+			primary = t.TeamName
+		}
+
+		weight := 1.0
+		if t.ReviewWeight != nil {
+			weight = *t.ReviewWeight
+		}
+		memberships = append(memberships, models.TeamMembership{TeamName: t.TeamName, IsPrimary: t.IsPrimary, ReviewWeight: weight})
+	}
+	if primary == "" {
+		return nil, fmt.Errorf("error: code: INVALID_MEMBERSHIP, message: exactly one primary team is required")
+	}
+
+	var result *models.User
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		user, err := s.usersRepo.GetUser(txCtx, req.UserID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+		if user.OffboardedAt != nil {
+			return errors.New("USER_OFFBOARDED")
+		}
+
+		for _, m := range memberships {
+			exists, err := s.teamsRepo.TeamExists(txCtx, m.TeamName)
+			if err != nil {
+				return fmt.Errorf("checking team: %w", err)
+			}
+			if !exists {
+				return errors.New("NOT_FOUND")
+			}
+		}
+
+		if err := s.usersRepo.ReplaceMemberships(txCtx, req.UserID, memberships); err != nil {
+			return fmt.Errorf("replacing memberships: %w", err)
+		}
+
+		if user.TeamName != primary {
+			if err := s.usersRepo.RecordTeamChange(txCtx, req.UserID, user.TeamName, primary); err != nil {
+				return fmt.Errorf("recording team change: %w", err)
+			}
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("getting updated user: %w", err)
+		}
+
+		result.Teams, err = s.usersRepo.GetUserMemberships(txCtx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("getting user memberships: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: user or team not found")
+		case "USER_OFFBOARDED":
+			return nil, fmt.Errorf("error: code: USER_OFFBOARDED, message: user is offboarded")
+		default:
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
+// OffboardUser releases the user's open reviews, replaces their username with a pseudonym and detaches
+// them from all teams. Their review history stays in place for statistics.
+func (s *UsersService) OffboardUser(ctx context.Context, req *models.OffboardUserRequest) (*models.User, []*models.ReviewHandover, error) {
+	policy := req.OpenReviews
+	if policy == "" {
+		policy = models.OpenReviewsReassign
+	}
+
+	var result *models.User
+	var handovers []*models.ReviewHandover
+
+	err := inTransaction(ctx, s.txMgr, func(txCtx context.Context) error {
+		user, err := s.usersRepo.GetUser(txCtx, req.UserID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+		if user.OffboardedAt != nil {
+			return errors.New("USER_OFFBOARDED")
+		}
+
+		handovers, err = s.handleOpenReviews(txCtx, req.UserID, policy, req.HandoverTo)
+		if err != nil {
+			return err
+		}
+
+		if err := s.usersRepo.OffboardUser(txCtx, req.UserID, newPseudonym()); err != nil {
+			return fmt.Errorf("offboarding user: %w", err)
+		}
+
+		if user.TeamName != "" {
+			if err := s.usersRepo.RecordTeamChange(txCtx, req.UserID, user.TeamName, ""); err != nil {
+				return fmt.Errorf("recording team change: %w", err)
+			}
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("getting offboarded user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return nil, nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+		case "USER_OFFBOARDED":
+			return nil, nil, fmt.Errorf("error: code: USER_OFFBOARDED, message: user is already offboarded")
+		default:
+			return nil, nil, err
+		}
+	}
+
+	return result, handovers, nil
+}
+
+func newPseudonym() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return "former-user-" + hex.EncodeToString(b)
+}
+
+func (s *UsersService) GetUser(ctx context.Context, userID string) (*models.User, error) {
+	user, err := s.usersRepo.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+
+	user.Teams, err = s.usersRepo.GetUserMemberships(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user memberships: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *UsersService) ListUsers(ctx context.Context, q *models.ListUsersQuery) (*models.UsersPage, error) {
+	limit := q.Limit
+	if limit == 0 {
+		limit = defaultUsersPageSize
+	}
+
+	users, err := s.usersRepo.SearchUsers(ctx, q, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+
+	page := &models.UsersPage{Users: users}
+	if len(users) > limit {
+		page.Users = users[:limit]
+		page.NextCursor = users[limit-1].UserID
+	}
+
+	return page, nil
+}
+
+func (s *UsersService) SetUserRole(ctx context.Context, userID string, role models.UserRole) (*models.User, error) {
+	var result *models.User
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		_, err := s.usersRepo.GetUser(txCtx, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+
+		if err := s.usersRepo.UpdateUserRole(txCtx, userID, role); err != nil {
+			return fmt.Errorf("updating user role: %w", err)
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, userID)
+		if err != nil {
+			return fmt.Errorf("getting updated user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if err.Error() == "NOT_FOUND" {
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *UsersService) UpdateProfile(ctx context.Context, req *models.UpdateProfileRequest) (*models.User, error) {
+	var result *models.User
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		user, err := s.usersRepo.GetUser(txCtx, req.UserID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+		if user.OffboardedAt != nil {
+			return errors.New("USER_OFFBOARDED")
+		}
+
+		profile := user.Profile
+		if profile == nil {
+			profile = &models.UserProfile{}
+		}
+		applyProfileUpdate(profile, req)
+
+		if err := s.usersRepo.UpdateUserProfile(txCtx, req.UserID, profile); err != nil {
+			return fmt.Errorf("updating user profile: %w", err)
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("getting updated user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "NOT_FOUND":
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+		case "USER_OFFBOARDED":
+			return nil, fmt.Errorf("error: code: USER_OFFBOARDED, message: user is offboarded")
+		default:
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func applyProfileUpdate(p *models.UserProfile, req *models.UpdateProfileRequest) {
+	if req.Email != nil {
+		p.Email = *req.Email
+	}
+	if req.ChatHandle != nil {
+		p.ChatHandle = *req.ChatHandle
+	}
+	if req.PreferredLanguage != nil {
+		p.PreferredLanguage = *req.PreferredLanguage
+	}
+
+	n := req.Notifications
+	if n == nil {
+		return
+	}
+	if n.Assigned != nil {
+		p.Notifications.Assigned = *n.Assigned
+	}
+	if n.Reassigned != nil {
+		p.Notifications.Reassigned = *n.Reassigned
+	}
+	if n.Overdue != nil {
+		p.Notifications.Overdue = *n.Overdue
+	}
+	if n.Merged != nil {
+		p.Notifications.Merged = *n.Merged
+	}
+}
+
+// CancelReactivation drops the pending reactivation; the user stays inactive until changed explicitly.
+func (s *UsersService) CancelReactivation(ctx context.Context, userID string) (*models.User, error) {
+	var result *models.User
+
+	err := s.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		user, err := s.usersRepo.GetUser(txCtx, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("NOT_FOUND")
+		}
+		if err != nil {
+			return fmt.Errorf("getting user: %w", err)
+		}
+		if user.InactiveUntil == nil {
+			result = user
+			return nil
+		}
+
+		if err := s.usersRepo.CancelReactivation(txCtx, userID); err != nil {
+			return fmt.Errorf("cancelling reactivation: %w", err)
+		}
+
+		result, err = s.usersRepo.GetUser(txCtx, userID)
+		if err != nil {
+			return fmt.Errorf("getting updated user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if err.Error() == "NOT_FOUND" {
+			return nil, fmt.Errorf("error: code: NOT_FOUND, message: user not found")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *UsersService) ReactivateExpired(ctx context.Context) ([]string, error) {
+	userIDs, err := s.usersRepo.ReactivateExpired(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("reactivating expired users: %w", err)
+	}
+	return userIDs, nil
+}
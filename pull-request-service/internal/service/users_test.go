@@ -3,8 +3,12 @@ package service_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -43,7 +47,7 @@ func TestUsersService_SetUserActiveStatus(t *testing.T) {
 				}
 
 				repo.On("GetUser", mock.Anything, "u1").Return(user, nil).Once()
-				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true).Return(nil).Once()
+				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true, (*time.Time)(nil)).Return(nil).Once()
 				repo.On("GetUser", mock.Anything, "u1").Return(updatedUser, nil).Once()
 
 				trx.EXPECT().
@@ -93,7 +97,7 @@ func TestUsersService_SetUserActiveStatus(t *testing.T) {
 				user := &models.User{UserID: "u1", TeamName: "backend", Username: "test", IsActive: false}
 
 				repo.On("GetUser", mock.Anything, "u1").Return(user, nil).Once()
-				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true).Return(errors.New("update error")).Once()
+				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true, (*time.Time)(nil)).Return(errors.New("update error")).Once()
 
 				trx.EXPECT().
 					WithTransaction(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
@@ -111,7 +115,7 @@ func TestUsersService_SetUserActiveStatus(t *testing.T) {
 				user := &models.User{UserID: "u1", TeamName: "backend", Username: "test", IsActive: false}
 
 				repo.On("GetUser", mock.Anything, "u1").Return(user, nil).Once()
-				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true).Return(nil).Once()
+				repo.On("UpdateUserActiveStatus", mock.Anything, "u1", true, (*time.Time)(nil)).Return(nil).Once()
 				repo.On("GetUser", mock.Anything, "u1").Return(nil, errors.New("get error")).Once()
 
 				trx.EXPECT().
@@ -131,11 +135,11 @@ func TestUsersService_SetUserActiveStatus(t *testing.T) {
 			repo := mocks.NewUsersRepository(t)
 			trx := mocks.NewTransactionManager(t)
 
-			svc := service.NewUsersService(repo, nil, trx)
+			svc := service.NewUsersService(repo, nil, nil, nil, trx)
 
 			tt.mockSetup(trx, repo)
 
-			res, err := svc.SetUserActiveStatus(ctx, "u1", true)
+			res, err := svc.SetUserActiveStatus(ctx, "u1", true, nil)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -178,7 +182,7 @@ func TestUsersService_GetUserReviews(t *testing.T) {
 			rRepo := mocks.NewUserReviewRepository(t)
 			tx := mocks.NewTransactionManager(t)
 
-			svc := service.NewUsersService(uRepo, rRepo, tx)
+			svc := service.NewUsersService(uRepo, rRepo, nil, nil, tx)
 
 			rRepo.EXPECT().
 				GetPRsByReviewer(mock.Anything, "u1").
@@ -200,21 +204,33 @@ func TestUsersService_GetUserReviews(t *testing.T) {
 func TestUsersService_GetReviewsStats(t *testing.T) {
 	ctx := context.Background()
 
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name    string
+		query   *models.ReviewsStatsQuery
 		ret     []*models.ReviewerStats
 		err     error
 		wantErr bool
 	}{
 		{
-			name: "success",
+			name:  "success",
+			query: &models.ReviewsStatsQuery{},
 			ret: []*models.ReviewerStats{
 				{UserID: "u1", ReviewsNumber: 10},
 				{UserID: "u2", ReviewsNumber: 5},
 			},
 		},
+		{
+			name:  "filtered",
+			query: &models.ReviewsStatsQuery{From: &from, TeamName: "backend", Status: models.StatusMerged},
+			ret: []*models.ReviewerStats{
+				{UserID: "u1", ReviewsNumber: 3, MergedReviews: 3},
+			},
+		},
 		{
 			name:    "repo error",
+			query:   &models.ReviewsStatsQuery{},
 			err:     errors.New("fail"),
 			wantErr: true,
 		},
@@ -226,13 +242,13 @@ func TestUsersService_GetReviewsStats(t *testing.T) {
 			rRepo := mocks.NewUserReviewRepository(t)
 			tx := mocks.NewTransactionManager(t)
 
-			svc := service.NewUsersService(uRepo, rRepo, tx)
+			svc := service.NewUsersService(uRepo, rRepo, nil, nil, tx)
 
 			rRepo.EXPECT().
-				GetReviewsStats(mock.Anything).
+				GetReviewsStats(mock.Anything, tt.query).
 				Return(tt.ret, tt.err)
 
-			res, err := svc.GetReviewsStats(ctx)
+			res, err := svc.GetReviewsStats(ctx, tt.query)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -244,3 +260,753 @@ func TestUsersService_GetReviewsStats(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersService_GetPickupStats(t *testing.T) {
+	ctx := context.Background()
+
+	userStats := []*models.UserPickupStats{
+		{UserID: "u1", PickedUp: 3, Pending: 1, AvgTimeToPickupSeconds: 120, AvgIdleSeconds: 60},
+	}
+	teamStats := []*models.TeamPickupStats{
+		{TeamName: "backend", PickedUp: 3, Pending: 1, AvgTimeToPickupSeconds: 120, AvgIdleSeconds: 60},
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(r *mocks.UserReviewRepository)
+		wantErr bool
+	}{
+		{
+			name: "success",
+			setup: func(r *mocks.UserReviewRepository) {
+				r.EXPECT().GetUserPickupStats(mock.Anything).Return(userStats, nil)
+				r.EXPECT().GetTeamPickupStats(mock.Anything).Return(teamStats, nil)
+			},
+		},
+		{
+			name: "user stats error",
+			setup: func(r *mocks.UserReviewRepository) {
+				r.EXPECT().GetUserPickupStats(mock.Anything).Return(nil, errors.New("fail"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "team stats error",
+			setup: func(r *mocks.UserReviewRepository) {
+				r.EXPECT().GetUserPickupStats(mock.Anything).Return(userStats, nil)
+				r.EXPECT().GetTeamPickupStats(mock.Anything).Return(nil, errors.New("fail"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			rRepo := mocks.NewUserReviewRepository(t)
+			tx := mocks.NewTransactionManager(t)
+
+			svc := service.NewUsersService(uRepo, rRepo, nil, nil, tx)
+
+			tt.setup(rRepo)
+
+			res, err := svc.GetPickupStats(ctx)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, userStats, res.Users)
+				assert.Equal(t, teamStats, res.Teams)
+			}
+		})
+	}
+}
+
+func TestUsersService_TransferUser(t *testing.T) {
+	ctx := context.Background()
+
+	user := &models.User{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+	moved := &models.User{UserID: "u1", Username: "alice", TeamName: "frontend", IsActive: true}
+	reviews := []*models.PullRequestShort{
+		{PullRequestID: "pr1", Status: models.StatusOpen},
+		{PullRequestID: "pr2", Status: models.StatusMerged},
+	}
+
+	tests := []struct {
+		name          string
+		req           *models.TransferUserRequest
+		setup         func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner)
+		wantHandovers []*models.ReviewHandover
+		wantErr       string
+	}{
+		{
+			name: "keep reviews",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend", OpenReviews: models.OpenReviewsKeep},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				u.EXPECT().UpdateUserTeam(mock.Anything, "u1", "frontend").Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "frontend").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(moved, nil).Once()
+			},
+		},
+		{
+			name: "reassign open reviews within old team",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend", OpenReviews: models.OpenReviewsReassign},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				r.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(reviews, nil)
+				ra.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u1", 0).Return(&models.PullRequest{}, "u2", nil)
+				u.EXPECT().UpdateUserTeam(mock.Anything, "u1", "frontend").Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "frontend").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(moved, nil).Once()
+			},
+			wantHandovers: []*models.ReviewHandover{{PullRequestID: "pr1", ReplacedBy: "u2"}},
+		},
+		{
+			name: "hand over open reviews",
+			req: &models.TransferUserRequest{
+				UserID:      "u1",
+				TeamName:    "frontend",
+				OpenReviews: models.OpenReviewsHandover,
+				HandoverTo:  "u3",
+			},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				u.EXPECT().GetUser(mock.Anything, "u3").Return(&models.User{UserID: "u3", IsActive: true}, nil)
+				r.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(reviews, nil)
+				ra.EXPECT().ReplaceReviewer(mock.Anything, "pr1", "u1", "u3").Return(&models.PullRequest{}, nil)
+				u.EXPECT().UpdateUserTeam(mock.Anything, "u1", "frontend").Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "frontend").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(moved, nil).Once()
+			},
+			wantHandovers: []*models.ReviewHandover{{PullRequestID: "pr1", ReplacedBy: "u3"}},
+		},
+		{
+			name: "handover target inactive",
+			req: &models.TransferUserRequest{
+				UserID:      "u1",
+				TeamName:    "frontend",
+				OpenReviews: models.OpenReviewsHandover,
+				HandoverTo:  "u3",
+			},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				u.EXPECT().GetUser(mock.Anything, "u3").Return(&models.User{UserID: "u3", IsActive: false}, nil)
+			},
+			wantErr: "NO_CANDIDATE",
+		},
+		{
+			name: "no replacement candidate",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend", OpenReviews: models.OpenReviewsReassign},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				r.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(reviews, nil)
+				ra.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u1", 0).
+					Return(nil, "", errors.New("error: code: NO_CANDIDATE, message: no active replacement candidate in team"))
+			},
+			wantErr: "NO_CANDIDATE",
+		},
+		{
+			name: "team not found",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(false, nil)
+			},
+			wantErr: "NOT_FOUND",
+		},
+		{
+			name: "user not found",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows)).Once()
+			},
+			wantErr: "error: code: NOT_FOUND, message: user not found",
+		},
+		{
+			name: "handover target not found",
+			req: &models.TransferUserRequest{
+				UserID:      "u1",
+				TeamName:    "frontend",
+				OpenReviews: models.OpenReviewsHandover,
+				HandoverTo:  "u3",
+			},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				u.EXPECT().GetUser(mock.Anything, "u3").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows))
+			},
+			wantErr: "error: code: NOT_FOUND, message: handover user not found",
+		},
+		{
+			name: "database failure is not reported as not found",
+			req:  &models.TransferUserRequest{UserID: "u1", TeamName: "frontend"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, tm *mocks.UserTeamsRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(nil, errors.New("connection reset")).Once()
+			},
+			wantErr: "getting user: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			rRepo := mocks.NewUserReviewRepository(t)
+			tRepo := mocks.NewUserTeamsRepository(t)
+			reassigner := mocks.NewReviewReassigner(t)
+			tx := mocks.NewTransactionManager(t)
+
+			expectTx(tx)
+			tt.setup(uRepo, rRepo, tRepo, reassigner)
+
+			svc := service.NewUsersService(uRepo, rRepo, tRepo, reassigner, tx)
+
+			res, handovers, err := svc.TransferUser(ctx, tt.req)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, moved, res)
+				assert.Equal(t, tt.wantHandovers, handovers)
+			}
+		})
+	}
+}
+
+func TestUsersService_SetUserTeams(t *testing.T) {
+	ctx := context.Background()
+	weight := 0.5
+
+	user := &models.User{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+
+	tests := []struct {
+		name      string
+		req       *models.SetUserTeamsRequest
+		setup     func(u *mocks.UsersRepository, tm *mocks.UserTeamsRepository)
+		wantTeams []models.TeamMembership
+		wantErr   string
+	}{
+		{
+			name: "primary team changes",
+			req: &models.SetUserTeamsRequest{UserID: "u1", Teams: []models.SetUserTeamsRequestItem{
+				{TeamName: "frontend", IsPrimary: true},
+				{TeamName: "backend", ReviewWeight: &weight},
+			}},
+			setup: func(u *mocks.UsersRepository, tm *mocks.UserTeamsRepository) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(true, nil)
+				tm.EXPECT().TeamExists(mock.Anything, "backend").Return(true, nil)
+				u.EXPECT().ReplaceMemberships(mock.Anything, "u1", []models.TeamMembership{
+					{TeamName: "frontend", IsPrimary: true, ReviewWeight: 1},
+					{TeamName: "backend", ReviewWeight: 0.5},
+				}).Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "frontend").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", TeamName: "frontend"}, nil).Once()
+				u.EXPECT().GetUserMemberships(mock.Anything, "u1").Return([]models.TeamMembership{
+					{TeamName: "frontend", IsPrimary: true, ReviewWeight: 1},
+					{TeamName: "backend", ReviewWeight: 0.5},
+				}, nil)
+			},
+			wantTeams: []models.TeamMembership{
+				{TeamName: "frontend", IsPrimary: true, ReviewWeight: 1},
+				{TeamName: "backend", ReviewWeight: 0.5},
+			},
+		},
+		{
+			name: "no primary team",
+			req: &models.SetUserTeamsRequest{UserID: "u1", Teams: []models.SetUserTeamsRequestItem{
+				{TeamName: "frontend"},
+			}},
+			wantErr: "INVALID_MEMBERSHIP",
+		},
+		{
+			name: "two primary teams",
+			req: &models.SetUserTeamsRequest{UserID: "u1", Teams: []models.SetUserTeamsRequestItem{
+				{TeamName: "frontend", IsPrimary: true},
+				{TeamName: "backend", IsPrimary: true},
+			}},
+			wantErr: "INVALID_MEMBERSHIP",
+		},
+		{
+			name: "team not found",
+			req: &models.SetUserTeamsRequest{UserID: "u1", Teams: []models.SetUserTeamsRequestItem{
+				{TeamName: "frontend", IsPrimary: true},
+			}},
+			setup: func(u *mocks.UsersRepository, tm *mocks.UserTeamsRepository) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil)
+				tm.EXPECT().TeamExists(mock.Anything, "frontend").Return(false, nil)
+			},
+			wantErr: "NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			rRepo := mocks.NewUserReviewRepository(t)
+			tRepo := mocks.NewUserTeamsRepository(t)
+			reassigner := mocks.NewReviewReassigner(t)
+			tx := mocks.NewTransactionManager(t)
+
+			if tt.setup != nil {
+				expectTx(tx)
+				tt.setup(uRepo, tRepo)
+			}
+
+			svc := service.NewUsersService(uRepo, rRepo, tRepo, reassigner, tx)
+
+			res, err := svc.SetUserTeams(ctx, tt.req)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "frontend", res.TeamName)
+				assert.Equal(t, tt.wantTeams, res.Teams)
+			}
+		})
+	}
+}
+
+func TestUsersService_ListUsers(t *testing.T) {
+	ctx := context.Background()
+
+	users := []*models.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}
+
+	tests := []struct {
+		name       string
+		query      *models.ListUsersQuery
+		limit      int
+		repoUsers  []*models.User
+		wantUsers  int
+		wantCursor string
+	}{
+		{
+			name:       "more pages",
+			query:      &models.ListUsersQuery{Limit: 2},
+			limit:      3,
+			repoUsers:  users,
+			wantUsers:  2,
+			wantCursor: "u2",
+		},
+		{
+			name:      "last page",
+			query:     &models.ListUsersQuery{Limit: 5, Cursor: "u0"},
+			limit:     6,
+			repoUsers: users,
+			wantUsers: 3,
+		},
+		{
+			name:      "default page size",
+			query:     &models.ListUsersQuery{Search: "al"},
+			limit:     51,
+			repoUsers: []*models.User{},
+			wantUsers: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			uRepo.EXPECT().SearchUsers(mock.Anything, tt.query, tt.limit).Return(tt.repoUsers, nil)
+
+			svc := service.NewUsersService(uRepo, nil, nil, nil, nil)
+
+			page, err := svc.ListUsers(ctx, tt.query)
+			require.NoError(t, err)
+			assert.Len(t, page.Users, tt.wantUsers)
+			assert.Equal(t, tt.wantCursor, page.NextCursor)
+		})
+	}
+}
+
+func TestUsersService_GetUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user with memberships", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+		uRepo.EXPECT().GetUserMemberships(mock.Anything, "u1").Return([]models.TeamMembership{{TeamName: "backend", IsPrimary: true, ReviewWeight: 1}}, nil)
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, nil)
+
+		user, err := svc.GetUser(ctx, "u1")
+		require.NoError(t, err)
+		assert.Len(t, user.Teams, 1)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		uRepo.EXPECT().GetUser(mock.Anything, "missing").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows))
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, nil)
+
+		user, err := svc.GetUser(ctx, "missing")
+		require.ErrorContains(t, err, "NOT_FOUND")
+		assert.Nil(t, user)
+	})
+
+	t.Run("repository failure is not reported as not found", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(nil, errors.New("connection refused"))
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, nil)
+
+		_, err := svc.GetUser(ctx, "u1")
+		require.ErrorContains(t, err, "connection refused")
+		assert.NotContains(t, err.Error(), "NOT_FOUND")
+	})
+}
+
+func TestUsersService_SetUserRole(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr string
+	}{
+		{name: "user not found", getErr: fmt.Errorf("getting user: %w", pgx.ErrNoRows), wantErr: "NOT_FOUND"},
+		{name: "repository failure", getErr: errors.New("connection refused"), wantErr: "connection refused"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+			expectTx(txMgr)
+			uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(nil, tt.getErr)
+
+			svc := service.NewUsersService(uRepo, nil, nil, nil, txMgr)
+
+			user, err := svc.SetUserRole(ctx, "u1", models.UserRoleLead)
+			require.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, user)
+		})
+	}
+
+	t.Run("role updated", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		txMgr := mocks.NewTransactionManager(t)
+		expectTx(txMgr)
+		uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1"}, nil).Once()
+		uRepo.EXPECT().UpdateUserRole(mock.Anything, "u1", models.UserRoleLead).Return(nil)
+		uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", Role: models.UserRoleLead}, nil).Once()
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, txMgr)
+
+		user, err := svc.SetUserRole(ctx, "u1", models.UserRoleLead)
+		require.NoError(t, err)
+		assert.Equal(t, models.UserRoleLead, user.Role)
+	})
+}
+
+func TestUsersService_OffboardUser(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	user := &models.User{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+	offboarded := &models.User{UserID: "u1", Username: "former-user-0a1b2c3d4e5f", OffboardedAt: &now}
+	reviews := []*models.PullRequestShort{
+		{PullRequestID: "pr1", Status: models.StatusOpen},
+		{PullRequestID: "pr2", Status: models.StatusMerged},
+	}
+
+	tests := []struct {
+		name          string
+		req           *models.OffboardUserRequest
+		setup         func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner)
+		wantHandovers []*models.ReviewHandover
+		wantErr       string
+	}{
+		{
+			name: "reassigns open reviews by default",
+			req:  &models.OffboardUserRequest{UserID: "u1"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+				r.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(reviews, nil)
+				ra.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u1", 0).Return(&models.PullRequest{}, "u2", nil)
+				u.EXPECT().OffboardUser(mock.Anything, "u1", mock.MatchedBy(func(p string) bool {
+					return strings.HasPrefix(p, "former-user-") && !strings.Contains(p, "alice")
+				})).Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(offboarded, nil).Once()
+			},
+			wantHandovers: []*models.ReviewHandover{{PullRequestID: "pr1", ReplacedBy: "u2"}},
+		},
+		{
+			name: "already offboarded",
+			req:  &models.OffboardUserRequest{UserID: "u1"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(offboarded, nil)
+			},
+			wantErr: "USER_OFFBOARDED",
+		},
+		{
+			name: "no replacement reviewer",
+			req:  &models.OffboardUserRequest{UserID: "u1"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil)
+				r.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(reviews, nil)
+				ra.EXPECT().ReassignReviewer(mock.Anything, "pr1", "u1", 0).Return(nil, "", errors.New("error: code: NO_CANDIDATE, message: no candidate"))
+			},
+			wantErr: "NO_CANDIDATE",
+		},
+		{
+			name: "user not found",
+			req:  &models.OffboardUserRequest{UserID: "missing"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "missing").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows))
+			},
+			wantErr: "NOT_FOUND",
+		},
+		{
+			name: "database failure is not reported as not found",
+			req:  &models.OffboardUserRequest{UserID: "u1"},
+			setup: func(u *mocks.UsersRepository, r *mocks.UserReviewRepository, ra *mocks.ReviewReassigner) {
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(nil, errors.New("connection reset"))
+			},
+			wantErr: "getting user: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			rRepo := mocks.NewUserReviewRepository(t)
+			reassigner := mocks.NewReviewReassigner(t)
+			tx := mocks.NewTransactionManager(t)
+
+			expectTx(tx)
+			tt.setup(uRepo, rRepo, reassigner)
+
+			svc := service.NewUsersService(uRepo, rRepo, nil, reassigner, tx)
+
+			res, handovers, err := svc.OffboardUser(ctx, tt.req)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, offboarded, res)
+				assert.Equal(t, tt.wantHandovers, handovers)
+			}
+		})
+	}
+}
+
+func TestUsersService_OffboardUser_ReviewEvents(t *testing.T) {
+	user := &models.User{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+
+	expectReassign := func(pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository, prID string, pool []string) {
+		pr.EXPECT().GetPRForUpdate(mock.Anything, prID).Return(&models.PullRequest{PullRequestID: prID, AuthorID: "author", Status: models.StatusOpen}, nil)
+		rev.EXPECT().GetPRReviewers(mock.Anything, prID).Return([]string{"u1"}, nil).Once()
+		team.EXPECT().GetActiveTeamCandidates(mock.Anything, "backend", "u1").Return(candidates(pool...), nil).Once()
+		if len(pool) == 0 {
+			team.EXPECT().GetParentTeam(mock.Anything, "backend").Return("", nil)
+			return
+		}
+		rev.EXPECT().RemoveReviewer(mock.Anything, prID, "u1").Return(nil)
+		rev.EXPECT().AddReviewer(mock.Anything, prID, pool[0]).Return(nil)
+		pr.EXPECT().BumpVersion(mock.Anything, prID).Return(nil)
+		pr.EXPECT().GetPR(mock.Anything, prID).Return(&models.PullRequest{PullRequestID: prID}, nil)
+		rev.EXPECT().GetPRReviewers(mock.Anything, prID).Return(pool[:1], nil).Once()
+	}
+
+	tests := []struct {
+		name            string
+		reviews         []*models.PullRequestShort
+		setup           func(u *mocks.UsersRepository, pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository)
+		wantReassigned  int
+		wantNoCandidate int
+		wantErr         string
+	}{
+		{
+			name:    "recorded after commit",
+			reviews: []*models.PullRequestShort{{PullRequestID: "pr1", Status: models.StatusOpen}},
+			setup: func(u *mocks.UsersRepository, pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
+				expectReassign(pr, rev, team, "pr1", []string{"c1"})
+				u.EXPECT().OffboardUser(mock.Anything, "u1", mock.Anything).Return(nil)
+				u.EXPECT().RecordTeamChange(mock.Anything, "u1", "backend", "").Return(nil)
+				u.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+			},
+			wantReassigned: 1,
+		},
+		{
+			name: "dropped when offboarding rolls back",
+			reviews: []*models.PullRequestShort{
+				{PullRequestID: "pr1", Status: models.StatusOpen},
+				{PullRequestID: "pr2", Status: models.StatusOpen},
+			},
+			setup: func(u *mocks.UsersRepository, pr *mocks.PullRequestRepository, rev *mocks.ReviewRepository, team *mocks.TeamInfoRepository) {
+				expectReassign(pr, rev, team, "pr1", []string{"c1"})
+				expectReassign(pr, rev, team, "pr2", nil)
+			},
+			wantNoCandidate: 1,
+			wantErr:         "NO_CANDIDATE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uRepo := mocks.NewUsersRepository(t)
+			urRepo := mocks.NewUserReviewRepository(t)
+			prRepo := mocks.NewPullRequestRepository(t)
+			revRepo := mocks.NewReviewRepository(t)
+			teamRepo := mocks.NewTeamInfoRepository(t)
+			txMgr := mocks.NewTransactionManager(t)
+			events := mocks.NewReviewEvents(t)
+
+			expectTx(txMgr)
+			uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(user, nil).Once()
+			urRepo.EXPECT().GetPRsByReviewer(mock.Anything, "u1").Return(tt.reviews, nil)
+			teamRepo.EXPECT().GetUserTeams(mock.Anything, "u1").Return([]string{"backend"}, nil)
+			tt.setup(uRepo, prRepo, revRepo, teamRepo)
+			if tt.wantReassigned > 0 {
+				events.EXPECT().Reassigned().Times(tt.wantReassigned)
+			}
+			if tt.wantNoCandidate > 0 {
+				events.EXPECT().NoCandidate().Times(tt.wantNoCandidate)
+			}
+
+			prSvc := service.NewPullRequestService(prRepo, revRepo, teamRepo, mocks.NewPRHistoryRepository(t), txMgr, models.DeleteModeSoft, events)
+			svc := service.NewUsersService(uRepo, urRepo, nil, prSvc, txMgr)
+
+			_, _, err := svc.OffboardUser(context.Background(), &models.OffboardUserRequest{UserID: "u1"})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsersService_UpdateProfile(t *testing.T) {
+	ctx := context.Background()
+
+	email := "alice@example.com"
+	off := false
+	current := &models.User{UserID: "u1", Profile: &models.UserProfile{
+		ChatHandle:    "@alice",
+		Notifications: models.NotificationPreferences{Assigned: true, Reassigned: true, Overdue: true, Merged: true},
+	}}
+
+	t.Run("only given fields change", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		tx := mocks.NewTransactionManager(t)
+
+		expectTx(tx)
+		uRepo.EXPECT().GetUser(mock.Anything, "u1").Return(current, nil)
+		uRepo.EXPECT().UpdateUserProfile(mock.Anything, "u1", &models.UserProfile{
+			Email:         email,
+			ChatHandle:    "@alice",
+			Notifications: models.NotificationPreferences{Assigned: true, Reassigned: true, Overdue: true, Merged: false},
+		}).Return(nil)
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, tx)
+
+		_, err := svc.UpdateProfile(ctx, &models.UpdateProfileRequest{
+			UserID:        "u1",
+			Email:         &email,
+			Notifications: &models.UpdateNotificationPreferences{Merged: &off},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		uRepo := mocks.NewUsersRepository(t)
+		tx := mocks.NewTransactionManager(t)
+
+		expectTx(tx)
+		uRepo.EXPECT().GetUser(mock.Anything, "missing").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows))
+
+		svc := service.NewUsersService(uRepo, nil, nil, nil, tx)
+
+		user, err := svc.UpdateProfile(ctx, &models.UpdateProfileRequest{UserID: "missing", Email: &email})
+		require.ErrorContains(t, err, "NOT_FOUND")
+		assert.Nil(t, user)
+	})
+}
+
+func TestUsersService_SetUserActiveStatus_Until(t *testing.T) {
+	ctx := context.Background()
+
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("temporary deactivation", func(t *testing.T) {
+		repo := mocks.NewUsersRepository(t)
+		trx := mocks.NewTransactionManager(t)
+
+		expectTx(trx)
+		repo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", IsActive: true}, nil).Once()
+		repo.EXPECT().UpdateUserActiveStatus(mock.Anything, "u1", false, mock.MatchedBy(func(until *time.Time) bool {
+			return until != nil && until.Equal(future) && until.Location() == time.UTC
+		})).Return(nil)
+		repo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", InactiveUntil: &future}, nil).Once()
+
+		svc := service.NewUsersService(repo, nil, nil, nil, trx)
+
+		res, err := svc.SetUserActiveStatus(ctx, "u1", false, &future)
+		require.NoError(t, err)
+		assert.Equal(t, &future, res.InactiveUntil)
+	})
+
+	for name, tc := range map[string]struct {
+		isActive bool
+		until    time.Time
+	}{
+		"until with activation": {isActive: true, until: future},
+		"until in the past":     {isActive: false, until: past},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc := service.NewUsersService(mocks.NewUsersRepository(t), nil, nil, nil, mocks.NewTransactionManager(t))
+
+			res, err := svc.SetUserActiveStatus(ctx, "u1", tc.isActive, &tc.until)
+			require.ErrorContains(t, err, "INVALID_UNTIL")
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestUsersService_CancelReactivation(t *testing.T) {
+	ctx := context.Background()
+	until := time.Now().Add(time.Hour)
+
+	repo := mocks.NewUsersRepository(t)
+	trx := mocks.NewTransactionManager(t)
+
+	expectTx(trx)
+	repo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1", InactiveUntil: &until}, nil).Once()
+	repo.EXPECT().CancelReactivation(mock.Anything, "u1").Return(nil)
+	repo.EXPECT().GetUser(mock.Anything, "u1").Return(&models.User{UserID: "u1"}, nil).Once()
+
+	svc := service.NewUsersService(repo, nil, nil, nil, trx)
+
+	res, err := svc.CancelReactivation(ctx, "u1")
+	require.NoError(t, err)
+	assert.Nil(t, res.InactiveUntil)
+
+	missingRepo := mocks.NewUsersRepository(t)
+	missingTrx := mocks.NewTransactionManager(t)
+
+	expectTx(missingTrx)
+	missingRepo.EXPECT().GetUser(mock.Anything, "missing").Return(nil, fmt.Errorf("getting user: %w", pgx.ErrNoRows))
+
+	svc = service.NewUsersService(missingRepo, nil, nil, nil, missingTrx)
+
+	_, err = svc.CancelReactivation(ctx, "missing")
+	require.ErrorContains(t, err, "error: code: NOT_FOUND")
+}